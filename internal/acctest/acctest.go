@@ -40,6 +40,7 @@ import (
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/jmespath/go-jmespath"
 )
 
@@ -254,6 +255,13 @@ func PreCheck(ctx context.Context, t *testing.T) {
 			t.Fatalf("configuring provider: %s", err)
 		}
 	})
+
+	// Save any API recorder cassettes once the test, including its destroy, has finished.
+	t.Cleanup(func() {
+		if err := vcr.SaveAll(); err != nil {
+			t.Error(err)
+		}
+	})
 }
 
 // ProviderAccountID returns the account ID of an AWS provider
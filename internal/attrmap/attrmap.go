@@ -7,7 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 )
 
 // AttributeMap represents a map of Terraform resource attribute name to AWS API attribute name.
@@ -72,7 +72,7 @@ func (m AttributeMap) APIAttributesToResourceData(apiAttributes map[string]strin
 				tfAttributeValue = v
 
 				if attributeInfo.isIAMPolicy {
-					policy, err := verify.PolicyToSet(d.Get(tfAttributeName).(string), tfAttributeValue.(string))
+					policy, err := tfpolicy.ToSet(d.Get(tfAttributeName).(string), tfAttributeValue.(string))
 
 					if err != nil {
 						return err
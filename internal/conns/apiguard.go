@@ -0,0 +1,105 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that never modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchDescribe",
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// IsReadOnlyOperation returns whether the named AWS API operation never modifies resources.
+func IsReadOnlyOperation(name string) bool {
+	for _, v := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type mutatingAPICallsPermittedKeyType int
+
+var mutatingAPICallsPermittedKey mutatingAPICallsPermittedKeyType
+
+// NewMutatingAPICallsPermittedContext returns a Context in which API calls that may modify resources are permitted
+// even if the client was configured to refuse them.
+func NewMutatingAPICallsPermittedContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mutatingAPICallsPermittedKey, true)
+}
+
+func mutatingAPICallsPermitted(ctx context.Context) bool {
+	v, _ := ctx.Value(mutatingAPICallsPermittedKey).(bool)
+	return v
+}
+
+// MutatingAPICallRefusedError is returned when an API call that may modify resources is refused.
+type MutatingAPICallRefusedError struct {
+	Service   string
+	Operation string
+}
+
+func (e *MutatingAPICallRefusedError) Error() string {
+	return fmt.Sprintf("refusing %s %s API call: API calls that may modify resources are only permitted from a Context returned by NewMutatingAPICallsPermittedContext", e.Service, e.Operation)
+}
+
+// refuseMutatingAPICallsSessionHandlers adds handlers that refuse API calls that may modify resources to an AWS SDK for Go v1 session.
+// The API clients created from the session inherit the handlers.
+func refuseMutatingAPICallsSessionHandlers(sess *session.Session) {
+	// Validate handlers are run before the request is built and sent.
+	sess.Handlers.Validate.PushFront(func(r *request.Request) {
+		if mutatingAPICallsPermitted(r.Context()) || IsReadOnlyOperation(r.Operation.Name) {
+			return
+		}
+
+		r.Error = &MutatingAPICallRefusedError{
+			Service:   r.ClientInfo.ServiceName,
+			Operation: r.Operation.Name,
+		}
+	})
+}
+
+// refuseMutatingAPICallsAPIOptions returns AWS SDK for Go v2 API options that refuse API calls that may modify resources.
+func refuseMutatingAPICallsAPIOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Run after the service metadata is registered.
+			return stack.Initialize.Add(&refuseMutatingAPICallsMiddleware{}, middleware.After)
+		},
+	}
+}
+
+type refuseMutatingAPICallsMiddleware struct{}
+
+func (m *refuseMutatingAPICallsMiddleware) ID() string {
+	return "TerraformAWSProviderRefuseMutatingAPICalls"
+}
+
+func (m *refuseMutatingAPICallsMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if operation := awsmiddleware.GetOperationName(ctx); !mutatingAPICallsPermitted(ctx) && !IsReadOnlyOperation(operation) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &MutatingAPICallRefusedError{
+			Service:   awsmiddleware.GetServiceID(ctx),
+			Operation: operation,
+		}
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,93 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeInstances":   true,
+		"GetBucketTagging":    true,
+		"ListTagsForResource": true,
+		"BatchGetItem":        true,
+		"DeleteCertificate":   false,
+		"TerminateInstances":  false,
+		"BatchWriteItem":      false,
+		"":                    false,
+	}
+
+	for name, expected := range testCases {
+		if got := IsReadOnlyOperation(name); got != expected {
+			t.Errorf("IsReadOnlyOperation(%q) = %t, want %t", name, got, expected)
+		}
+	}
+}
+
+func TestRefuseMutatingAPICallsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Operation     string
+		Permitted     bool
+		ExpectedError bool
+	}{
+		{
+			Name:      "read only",
+			Operation: "DescribeThings",
+		},
+		{
+			Name:          "mutating",
+			Operation:     "DeleteThing",
+			ExpectedError: true,
+		},
+		{
+			Name:      "mutating permitted",
+			Operation: "DeleteThing",
+			Permitted: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.Permitted {
+				ctx = NewMutatingAPICallsPermittedContext(ctx)
+			}
+
+			var called bool
+			next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				called = true
+
+				return middleware.InitializeOutput{}, middleware.Metadata{}, nil
+			})
+
+			m := &refuseMutatingAPICallsMiddleware{}
+			metadata := awsmiddleware.RegisterServiceMetadata{ServiceID: "Test", OperationName: testCase.Operation}
+			_, _, err := metadata.HandleInitialize(ctx, middleware.InitializeInput{}, middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				return m.HandleInitialize(ctx, in, next)
+			}))
+
+			var refused *MutatingAPICallRefusedError
+
+			if got, want := errors.As(err, &refused), testCase.ExpectedError; got != want {
+				t.Fatalf("refused = %t, want %t (error: %v)", got, want, err)
+			}
+
+			if got, want := called, !testCase.ExpectedError; got != want {
+				t.Errorf("called = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package conns
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+// apiRecorderHTTPClient returns an HTTP client that records or replays all AWS API interactions.
+// The same HTTP client is used by AWS SDK for Go v1 and v2 API clients.
+func (c *Config) apiRecorderHTTPClient(ctx context.Context) (*http.Client, error) {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+	tlsConfig.InsecureSkipVerify = c.Insecure //nolint:gosec // Configured by the practitioner.
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, err
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	r, err := vcr.NewRecorder(ctx, c.APIRecorderConfig, transport)
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient.Transport = r
+
+	return httpClient, nil
+}
+
+// apiRecorderSessionHandlers configures an AWS SDK for Go v1 session not to retry requests
+// for which no recorded interaction is found.
+// API clients created from copies of the session inherit the handlers.
+func apiRecorderSessionHandlers(sess *session.Session) {
+	sess.Handlers.AfterRetry.PushFront(func(r *request.Request) {
+		if vcr.IsInteractionNotFound(r.Error) {
+			r.Retryable = aws_sdkv1.Bool(false)
+		}
+	})
+}
+
+// apiRecorderRetryer wraps an AWS SDK for Go v2 retryer so as not to retry requests
+// for which no recorded interaction is found.
+type apiRecorderRetryer struct {
+	aws_sdkv2.RetryerV2
+}
+
+func newAPIRecorderRetryer(retryer aws_sdkv2.Retryer) aws_sdkv2.Retryer {
+	if v, ok := retryer.(aws_sdkv2.RetryerV2); ok {
+		return &apiRecorderRetryer{RetryerV2: v}
+	}
+
+	return retryer
+}
+
+func (r *apiRecorderRetryer) IsErrorRetryable(err error) bool {
+	if vcr.IsInteractionNotFound(err) {
+		return false
+	}
+
+	return r.RetryerV2.IsErrorRetryable(err)
+}
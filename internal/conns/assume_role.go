@@ -0,0 +1,79 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleChain assumes each of the IAM Roles after the first in the configured chain in turn,
+// starting with the credentials of the first role, which are assumed by aws-sdk-go-base.
+// It returns a provider of the credentials of the last role in the chain.
+func (c *Config) assumeRoleChain(ctx context.Context, cfg aws_sdkv2.Config) (aws_sdkv2.CredentialsProvider, error) {
+	n := len(c.AssumeRole)
+
+	for i := 1; i < n; i++ {
+		ar := c.AssumeRole[i]
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.hop":             i + 1,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+
+			if v := c.Endpoints[names.STS]; v != "" {
+				o.EndpointResolver = sts.EndpointResolverFromURL(v)
+			}
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = ar.SessionName
+			o.Duration = ar.Duration
+
+			if ar.ExternalID != "" {
+				o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+			}
+
+			if ar.Policy != "" {
+				o.Policy = aws_sdkv2.String(ar.Policy)
+			}
+
+			for _, v := range ar.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws_sdkv2.String(v)})
+			}
+
+			for k, v := range ar.Tags {
+				o.Tags = append(o.Tags, ststypes.Tag{Key: aws_sdkv2.String(k), Value: aws_sdkv2.String(v)})
+			}
+
+			if len(ar.TransitiveTagKeys) > 0 {
+				o.TransitiveTagKeys = ar.TransitiveTagKeys
+			}
+
+			if ar.SourceIdentity != "" {
+				o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+			}
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assume_role %d of %d: assuming IAM Role (%s): %w", i+1, n, ar.RoleARN, err)
+		}
+
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return cfg.Credentials, nil
+}
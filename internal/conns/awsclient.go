@@ -1,12 +1,14 @@
 package conns
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/s3"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -40,6 +42,16 @@ func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
 
+// DefaultTagsConfigForContext returns the default tags configuration for the resource in Context.
+// Any scoped default tags are applied to the resource.
+func (client *AWSClient) DefaultTagsConfigForContext(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := tftags.FromContext(ctx); ok {
+		return v.DefaultConfig
+	}
+
+	return client.DefaultTagsConfig
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (client *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
@@ -328,23 +328,34 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/workspacesweb"
 	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 type AWSClient struct {
 	AccountID               string
+	APICallJournalWriter    *APICallJournalWriter
 	DefaultTagsConfig       *tftags.DefaultConfig
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
+	Interceptors            interceptors.Chain
 	MediaConvertAccountConn *mediaconvert.MediaConvert
 	Partition               string
+	PolicyLintConfig        *tfpolicy.LintConfig
 	Region                  string
+	RetryConfig             *tfresource.RetryConfig
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session.Session
+	TagBatcher              *tftags.Batcher
+	TagPolicyConfig         *tftags.PolicyConfig
 	TerraformVersion        string
 
-	httpClient *http.Client
+	credentialsChainResolver *credentialsChainResolver
+	httpClient               *http.Client
+	regionalClients          *regionalClients
 
 	dsClient        lazyClient[*directoryservice_sdkv2.Client]
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
@@ -2,9 +2,12 @@ package conns
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"os"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
@@ -41,15 +44,23 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type Config struct {
+	APIRecorderConfig              *vcr.Config
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole // Assumed in order
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchTagUpdates                bool
+	CredentialsDiagnostics         bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -60,9 +71,14 @@ type Config struct {
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	Interceptors                   interceptors.Chain
 	MaxRetries                     int
+	PolicyLintConfig               *tfpolicy.LintConfig
 	Profile                        string
+	RateLimits                     []RateLimit
+	RefuseMutatingAPICalls         bool
 	Region                         string
+	RetryConfig                    *tfresource.RetryConfig
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -73,14 +89,26 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+
+	rateLimiters *rateLimiters
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
+	// An HTTP client set before configuration, e.g. by acceptance tests, takes precedence.
+	if c.APIRecorderConfig != nil && client.HTTPClient() == nil {
+		httpClient, err := c.apiRecorderHTTPClient(ctx)
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider API recorder: %s", err)
+		}
+		client.SetHTTPClient(httpClient)
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -105,8 +133,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// aws-sdk-go-base assumes the first IAM Role in the chain; any others are assumed below.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -133,6 +162,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
+		if n := len(c.AssumeRole); n > 1 && awsbase.IsCannotAssumeRoleError(err) {
+			err = fmt.Errorf("assume_role 1 of %d: %w", n, err)
+		}
+
+		if c.CredentialsDiagnostics {
+			return nil, diag.Diagnostics{diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("configuring Terraform AWS Provider: %s", err),
+				Detail:   credentialsDiagnosticsDetail(ctx, c),
+			}}
+		}
+
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
@@ -143,12 +184,32 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if len(c.AssumeRole) > 1 {
+		credentials, err := c.assumeRoleChain(ctx, cfg)
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+		cfg.Credentials = credentials
+	}
+
+	if c.APIRecorderConfig != nil && c.APIRecorderConfig.Mode == vcr.ModeReplaying {
+		if v := cfg.Retryer; v != nil {
+			cfg.Retryer = func() aws_sdkv2.Retryer {
+				return newAPIRecorderRetryer(v())
+			}
+		}
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	sess, err := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.APIRecorderConfig != nil && c.APIRecorderConfig.Mode == vcr.ModeReplaying {
+		apiRecorderSessionHandlers(sess)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -189,12 +250,54 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.Interceptors = c.Interceptors
 	client.Partition = partition
+	client.PolicyLintConfig = c.PolicyLintConfig
 	client.Region = c.Region
+	client.RetryConfig = c.RetryConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TagPolicyConfig = c.TagPolicyConfig
+	if c.BatchTagUpdates {
+		client.TagBatcher = tftags.NewBatcher(tftags.DefaultBatchWindow, tftags.DefaultBatchSize)
+	}
 	client.TerraformVersion = c.TerraformVersion
+	client.regionalClients = newRegionalClients(c)
+	client.credentialsChainResolver = newCredentialsChainResolver(awsbaseConfig, c.AssumeRole, cfg)
+
+	if c.CredentialsDiagnostics {
+		chain, err := client.CredentialsChain(ctx)
+
+		if err != nil {
+			return nil, diag.Errorf("resolving AWS credentials chain: %s", err)
+		}
+
+		tflog.Info(ctx, "Resolved AWS credentials chain", map[string]any{
+			"tf_aws.credentials_chain.source":        chain.Source,
+			"tf_aws.credentials_chain.provider_name": chain.ProviderName,
+			"tf_aws.credentials_chain.account_id":    chain.AccountID,
+			"tf_aws.credentials_chain.partition":     chain.Partition,
+			"tf_aws.credentials_chain.assumed_roles": chain.AssumedRoles,
+			"tf_aws.credentials_chain.expires":       chain.Expires,
+		})
+	}
+
+	c.rateLimiters = newRateLimiters(c.RateLimits)
+
+	createTagsSessionHandlers(sess)
+	cfg.APIOptions = append(cfg.APIOptions, createTagsAPIOptions()...)
+
+	apiCallJournalSessionHandlers(sess)
+	cfg.APIOptions = append(cfg.APIOptions, apiCallJournalAPIOptions()...)
+	if v := os.Getenv(envvar.APICallJournal); v != "" {
+		client.APICallJournalWriter = NewAPICallJournalWriter(v)
+	}
+
+	if c.RefuseMutatingAPICalls {
+		refuseMutatingAPICallsSessionHandlers(sess)
+		cfg.APIOptions = append(cfg.APIOptions, refuseMutatingAPICallsAPIOptions()...)
+	}
 
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
@@ -210,17 +313,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	if c.STSRegion != "" {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
-	client.stsConn = sts.New(sess.Copy(stsConfig))
+	client.stsConn = sts.New(c.rateLimitSession(names.STS, sess.Copy(stsConfig)))
 
 	// Services that require multiple client configurations.
 	s3Config := &aws.Config{
 		Endpoint:         aws.String(c.Endpoints[names.S3]),
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
-	client.s3Conn = s3.New(sess.Copy(s3Config))
+	client.s3Conn = s3.New(c.rateLimitSession(names.S3, sess.Copy(s3Config)))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.s3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	client.s3ConnURICleaningDisabled = s3.New(c.rateLimitSession(names.S3, sess.Copy(s3Config)))
 
 	// "Global" services that require customizations.
 	globalAcceleratorConfig := &aws.Config{
@@ -258,11 +361,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.route53Conn = route53.New(sess.Copy(route53Config))
-	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.route53recoveryreadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.shieldConn = shield.New(sess.Copy(shieldConfig))
+	client.globalacceleratorConn = globalaccelerator.New(c.rateLimitSession(names.GlobalAccelerator, sess.Copy(globalAcceleratorConfig)))
+	client.route53Conn = route53.New(c.rateLimitSession(names.Route53, sess.Copy(route53Config)))
+	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(c.rateLimitSession(names.Route53RecoveryControlConfig, sess.Copy(route53RecoveryControlConfigConfig)))
+	client.route53recoveryreadinessConn = route53recoveryreadiness.New(c.rateLimitSession(names.Route53RecoveryReadiness, sess.Copy(route53RecoveryReadinessConfig)))
+	client.shieldConn = shield.New(c.rateLimitSession(names.Shield, sess.Copy(shieldConfig)))
 
 	client.apigatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Many operations can return an error such as:
@@ -545,6 +648,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Route53Domains)...)
 	})
 
 	return client, nil
@@ -325,287 +325,287 @@ import (
 
 // sdkv1Conns initializes AWS SDK for Go v1 clients.
 func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
-	client.acmConn = acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])}))
-	client.acmpcaConn = acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])}))
-	client.ampConn = prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])}))
-	client.apigatewayConn = apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])}))
-	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])}))
-	client.apigatewayv2Conn = apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])}))
-	client.accessanalyzerConn = accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])}))
-	client.alexaforbusinessConn = alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])}))
-	client.amplifyConn = amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])}))
-	client.amplifybackendConn = amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])}))
-	client.amplifyuibuilderConn = amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])}))
-	client.applicationautoscalingConn = applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])}))
-	client.appconfigConn = appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])}))
-	client.appconfigdataConn = appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])}))
-	client.appflowConn = appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])}))
-	client.appintegrationsConn = appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])}))
-	client.appmeshConn = appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])}))
-	client.apprunnerConn = apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])}))
-	client.appstreamConn = appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])}))
-	client.appsyncConn = appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])}))
-	client.applicationcostprofilerConn = applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])}))
-	client.applicationinsightsConn = applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])}))
-	client.athenaConn = athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])}))
-	client.autoscalingConn = autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])}))
-	client.autoscalingplansConn = autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])}))
-	client.backupConn = backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])}))
-	client.backupgatewayConn = backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])}))
-	client.batchConn = batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])}))
-	client.billingconductorConn = billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])}))
-	client.braketConn = braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])}))
-	client.budgetsConn = budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])}))
-	client.ceConn = costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])}))
-	client.curConn = costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])}))
-	client.chimeConn = chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])}))
-	client.chimesdkidentityConn = chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])}))
-	client.chimesdkmediapipelinesConn = chimesdkmediapipelines.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMediaPipelines])}))
-	client.chimesdkmeetingsConn = chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])}))
-	client.chimesdkmessagingConn = chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])}))
-	client.chimesdkvoiceConn = chimesdkvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKVoice])}))
-	client.cloud9Conn = cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])}))
-	client.clouddirectoryConn = clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])}))
-	client.cloudformationConn = cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])}))
-	client.cloudfrontConn = cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])}))
-	client.cloudhsmv2Conn = cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])}))
-	client.cloudsearchConn = cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])}))
-	client.cloudsearchdomainConn = cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])}))
-	client.cloudtrailConn = cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])}))
-	client.cloudwatchConn = cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])}))
-	client.codeartifactConn = codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])}))
-	client.codebuildConn = codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])}))
-	client.codecommitConn = codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])}))
-	client.codeguruprofilerConn = codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])}))
-	client.codegurureviewerConn = codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])}))
-	client.codepipelineConn = codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])}))
-	client.codestarConn = codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])}))
-	client.codestarconnectionsConn = codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])}))
-	client.codestarnotificationsConn = codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])}))
-	client.cognitoidpConn = cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])}))
-	client.cognitoidentityConn = cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])}))
-	client.cognitosyncConn = cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])}))
-	client.comprehendmedicalConn = comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])}))
-	client.configserviceConn = configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])}))
-	client.connectConn = connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])}))
-	client.connectcontactlensConn = connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])}))
-	client.connectparticipantConn = connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])}))
-	client.controltowerConn = controltower.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])}))
-	client.customerprofilesConn = customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])}))
-	client.daxConn = dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])}))
-	client.dlmConn = dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])}))
-	client.dmsConn = databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])}))
-	client.drsConn = drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])}))
-	client.dsConn = directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])}))
-	client.databrewConn = gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])}))
-	client.dataexchangeConn = dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])}))
-	client.datapipelineConn = datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])}))
-	client.datasyncConn = datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])}))
-	client.deployConn = codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])}))
-	client.detectiveConn = detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])}))
-	client.devopsguruConn = devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])}))
-	client.devicefarmConn = devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])}))
-	client.directconnectConn = directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])}))
-	client.discoveryConn = applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])}))
-	client.docdbConn = docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])}))
-	client.dynamodbConn = dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])}))
-	client.dynamodbstreamsConn = dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])}))
-	client.ebsConn = ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])}))
-	client.ec2Conn = ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])}))
-	client.ec2instanceconnectConn = ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])}))
-	client.ecrConn = ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])}))
-	client.ecrpublicConn = ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])}))
-	client.ecsConn = ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])}))
-	client.efsConn = efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])}))
-	client.eksConn = eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])}))
-	client.elbConn = elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])}))
-	client.elbv2Conn = elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])}))
-	client.emrConn = emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])}))
-	client.emrcontainersConn = emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])}))
-	client.emrserverlessConn = emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])}))
-	client.elasticacheConn = elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])}))
-	client.elasticbeanstalkConn = elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])}))
-	client.elasticinferenceConn = elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])}))
-	client.elastictranscoderConn = elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])}))
-	client.esConn = elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])}))
-	client.eventsConn = eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])}))
-	client.evidentlyConn = cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])}))
-	client.fmsConn = fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])}))
-	client.fsxConn = fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])}))
-	client.finspaceConn = finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])}))
-	client.finspacedataConn = finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])}))
-	client.firehoseConn = firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])}))
-	client.forecastConn = forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])}))
-	client.forecastqueryConn = forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])}))
-	client.frauddetectorConn = frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])}))
-	client.gameliftConn = gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])}))
-	client.glacierConn = glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])}))
-	client.glueConn = glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])}))
-	client.grafanaConn = managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])}))
-	client.greengrassConn = greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])}))
-	client.greengrassv2Conn = greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])}))
-	client.groundstationConn = groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])}))
-	client.guarddutyConn = guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])}))
-	client.healthConn = health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])}))
-	client.honeycodeConn = honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])}))
-	client.iamConn = iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])}))
-	client.ivsConn = ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])}))
-	client.imagebuilderConn = imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])}))
-	client.inspectorConn = inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])}))
-	client.internetmonitorConn = internetmonitor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.InternetMonitor])}))
-	client.iotConn = iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])}))
-	client.iot1clickdevicesConn = iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])}))
-	client.iot1clickprojectsConn = iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])}))
-	client.iotanalyticsConn = iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])}))
-	client.iotdataConn = iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])}))
-	client.iotdeviceadvisorConn = iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])}))
-	client.ioteventsConn = iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])}))
-	client.ioteventsdataConn = ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])}))
-	client.iotfleethubConn = iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])}))
-	client.iotjobsdataConn = iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])}))
-	client.iotsecuretunnelingConn = iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])}))
-	client.iotsitewiseConn = iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])}))
-	client.iotthingsgraphConn = iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])}))
-	client.iottwinmakerConn = iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])}))
-	client.iotwirelessConn = iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])}))
-	client.kmsConn = kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])}))
-	client.kafkaConn = kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])}))
-	client.kafkaconnectConn = kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])}))
-	client.keyspacesConn = keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])}))
-	client.kinesisConn = kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])}))
-	client.kinesisanalyticsConn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])}))
-	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])}))
-	client.kinesisvideoConn = kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])}))
-	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])}))
-	client.kinesisvideomediaConn = kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])}))
-	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])}))
-	client.lakeformationConn = lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])}))
-	client.lambdaConn = lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])}))
-	client.lexmodelsConn = lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])}))
-	client.lexmodelsv2Conn = lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])}))
-	client.lexruntimeConn = lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])}))
-	client.lexruntimev2Conn = lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])}))
-	client.licensemanagerConn = licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])}))
-	client.lightsailConn = lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])}))
-	client.locationConn = locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])}))
-	client.logsConn = cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])}))
-	client.lookoutequipmentConn = lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])}))
-	client.lookoutmetricsConn = lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])}))
-	client.lookoutvisionConn = lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])}))
-	client.mqConn = mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])}))
-	client.mturkConn = mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])}))
-	client.mwaaConn = mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])}))
-	client.machinelearningConn = machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])}))
-	client.macieConn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])}))
-	client.macie2Conn = macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])}))
-	client.managedblockchainConn = managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])}))
-	client.marketplacecatalogConn = marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])}))
-	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])}))
-	client.marketplaceentitlementConn = marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])}))
-	client.marketplacemeteringConn = marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])}))
-	client.mediaconnectConn = mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])}))
-	client.mediaconvertConn = mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])}))
-	client.mediapackageConn = mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])}))
-	client.mediapackagevodConn = mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])}))
-	client.mediastoreConn = mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])}))
-	client.mediastoredataConn = mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])}))
-	client.mediatailorConn = mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])}))
-	client.memorydbConn = memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])}))
-	client.mghConn = migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])}))
-	client.mgnConn = mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])}))
-	client.migrationhubconfigConn = migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])}))
-	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])}))
-	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])}))
-	client.mobileConn = mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])}))
-	client.neptuneConn = neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])}))
-	client.networkfirewallConn = networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])}))
-	client.networkmanagerConn = networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])}))
-	client.nimbleConn = nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])}))
-	client.opensearchConn = opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])}))
-	client.opsworksConn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])}))
-	client.opsworkscmConn = opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])}))
-	client.organizationsConn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])}))
-	client.outpostsConn = outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])}))
-	client.piConn = pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])}))
-	client.panoramaConn = panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])}))
-	client.personalizeConn = personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])}))
-	client.personalizeeventsConn = personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])}))
-	client.personalizeruntimeConn = personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])}))
-	client.pinpointConn = pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])}))
-	client.pinpointemailConn = pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])}))
-	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])}))
-	client.pollyConn = polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])}))
-	client.pricingConn = pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])}))
-	client.protonConn = proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])}))
-	client.qldbConn = qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])}))
-	client.qldbsessionConn = qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])}))
-	client.quicksightConn = quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])}))
-	client.ramConn = ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])}))
-	client.rdsConn = rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])}))
-	client.rdsdataConn = rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])}))
-	client.rumConn = cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])}))
-	client.redshiftConn = redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])}))
-	client.redshiftdataConn = redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])}))
-	client.redshiftserverlessConn = redshiftserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])}))
-	client.rekognitionConn = rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])}))
-	client.resiliencehubConn = resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])}))
-	client.resourcegroupsConn = resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])}))
-	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])}))
-	client.robomakerConn = robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])}))
-	client.route53recoveryclusterConn = route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])}))
-	client.route53resolverConn = route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])}))
-	client.s3controlConn = s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])}))
-	client.s3outpostsConn = s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])}))
-	client.sesConn = ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])}))
-	client.sfnConn = sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])}))
-	client.smsConn = sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])}))
-	client.snsConn = sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])}))
-	client.sqsConn = sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])}))
-	client.ssmConn = ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])}))
-	client.ssoConn = sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])}))
-	client.ssoadminConn = ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])}))
-	client.ssooidcConn = ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])}))
-	client.swfConn = swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])}))
-	client.sagemakerConn = sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])}))
-	client.sagemakera2iruntimeConn = augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])}))
-	client.sagemakeredgeConn = sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])}))
-	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])}))
-	client.sagemakerruntimeConn = sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])}))
-	client.savingsplansConn = savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])}))
-	client.schemasConn = schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])}))
-	client.secretsmanagerConn = secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])}))
-	client.securityhubConn = securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])}))
-	client.serverlessrepoConn = serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])}))
-	client.servicecatalogConn = servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])}))
-	client.servicecatalogappregistryConn = appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])}))
-	client.servicediscoveryConn = servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])}))
-	client.servicequotasConn = servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])}))
-	client.signerConn = signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])}))
-	client.sdbConn = simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])}))
-	client.snowdevicemanagementConn = snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])}))
-	client.snowballConn = snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])}))
-	client.storagegatewayConn = storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])}))
-	client.supportConn = support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])}))
-	client.syntheticsConn = synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])}))
-	client.textractConn = textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])}))
-	client.timestreamqueryConn = timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])}))
-	client.timestreamwriteConn = timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])}))
-	client.transcribestreamingConn = transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])}))
-	client.transferConn = transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])}))
-	client.translateConn = translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])}))
-	client.voiceidConn = voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])}))
-	client.wafConn = waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])}))
-	client.wafregionalConn = wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])}))
-	client.wafv2Conn = wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])}))
-	client.wellarchitectedConn = wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])}))
-	client.wisdomConn = connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])}))
-	client.workdocsConn = workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])}))
-	client.worklinkConn = worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])}))
-	client.workmailConn = workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])}))
-	client.workmailmessageflowConn = workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])}))
-	client.workspacesConn = workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])}))
-	client.workspaceswebConn = workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])}))
-	client.xrayConn = xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])}))
+	client.acmConn = acm.New(c.rateLimitSession(names.ACM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])})))
+	client.acmpcaConn = acmpca.New(c.rateLimitSession(names.ACMPCA, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])})))
+	client.ampConn = prometheusservice.New(c.rateLimitSession(names.AMP, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])})))
+	client.apigatewayConn = apigateway.New(c.rateLimitSession(names.APIGateway, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])})))
+	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(c.rateLimitSession(names.APIGatewayManagementAPI, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])})))
+	client.apigatewayv2Conn = apigatewayv2.New(c.rateLimitSession(names.APIGatewayV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])})))
+	client.accessanalyzerConn = accessanalyzer.New(c.rateLimitSession(names.AccessAnalyzer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])})))
+	client.alexaforbusinessConn = alexaforbusiness.New(c.rateLimitSession(names.AlexaForBusiness, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])})))
+	client.amplifyConn = amplify.New(c.rateLimitSession(names.Amplify, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])})))
+	client.amplifybackendConn = amplifybackend.New(c.rateLimitSession(names.AmplifyBackend, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])})))
+	client.amplifyuibuilderConn = amplifyuibuilder.New(c.rateLimitSession(names.AmplifyUIBuilder, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])})))
+	client.applicationautoscalingConn = applicationautoscaling.New(c.rateLimitSession(names.AppAutoScaling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])})))
+	client.appconfigConn = appconfig.New(c.rateLimitSession(names.AppConfig, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])})))
+	client.appconfigdataConn = appconfigdata.New(c.rateLimitSession(names.AppConfigData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])})))
+	client.appflowConn = appflow.New(c.rateLimitSession(names.AppFlow, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])})))
+	client.appintegrationsConn = appintegrationsservice.New(c.rateLimitSession(names.AppIntegrations, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])})))
+	client.appmeshConn = appmesh.New(c.rateLimitSession(names.AppMesh, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])})))
+	client.apprunnerConn = apprunner.New(c.rateLimitSession(names.AppRunner, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])})))
+	client.appstreamConn = appstream.New(c.rateLimitSession(names.AppStream, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])})))
+	client.appsyncConn = appsync.New(c.rateLimitSession(names.AppSync, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])})))
+	client.applicationcostprofilerConn = applicationcostprofiler.New(c.rateLimitSession(names.ApplicationCostProfiler, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])})))
+	client.applicationinsightsConn = applicationinsights.New(c.rateLimitSession(names.ApplicationInsights, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])})))
+	client.athenaConn = athena.New(c.rateLimitSession(names.Athena, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])})))
+	client.autoscalingConn = autoscaling.New(c.rateLimitSession(names.AutoScaling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])})))
+	client.autoscalingplansConn = autoscalingplans.New(c.rateLimitSession(names.AutoScalingPlans, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])})))
+	client.backupConn = backup.New(c.rateLimitSession(names.Backup, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])})))
+	client.backupgatewayConn = backupgateway.New(c.rateLimitSession(names.BackupGateway, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])})))
+	client.batchConn = batch.New(c.rateLimitSession(names.Batch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])})))
+	client.billingconductorConn = billingconductor.New(c.rateLimitSession(names.BillingConductor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])})))
+	client.braketConn = braket.New(c.rateLimitSession(names.Braket, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])})))
+	client.budgetsConn = budgets.New(c.rateLimitSession(names.Budgets, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])})))
+	client.ceConn = costexplorer.New(c.rateLimitSession(names.CE, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])})))
+	client.curConn = costandusagereportservice.New(c.rateLimitSession(names.CUR, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])})))
+	client.chimeConn = chime.New(c.rateLimitSession(names.Chime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])})))
+	client.chimesdkidentityConn = chimesdkidentity.New(c.rateLimitSession(names.ChimeSDKIdentity, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])})))
+	client.chimesdkmediapipelinesConn = chimesdkmediapipelines.New(c.rateLimitSession(names.ChimeSDKMediaPipelines, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMediaPipelines])})))
+	client.chimesdkmeetingsConn = chimesdkmeetings.New(c.rateLimitSession(names.ChimeSDKMeetings, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])})))
+	client.chimesdkmessagingConn = chimesdkmessaging.New(c.rateLimitSession(names.ChimeSDKMessaging, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])})))
+	client.chimesdkvoiceConn = chimesdkvoice.New(c.rateLimitSession(names.ChimeSDKVoice, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKVoice])})))
+	client.cloud9Conn = cloud9.New(c.rateLimitSession(names.Cloud9, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])})))
+	client.clouddirectoryConn = clouddirectory.New(c.rateLimitSession(names.CloudDirectory, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])})))
+	client.cloudformationConn = cloudformation.New(c.rateLimitSession(names.CloudFormation, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])})))
+	client.cloudfrontConn = cloudfront.New(c.rateLimitSession(names.CloudFront, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])})))
+	client.cloudhsmv2Conn = cloudhsmv2.New(c.rateLimitSession(names.CloudHSMV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])})))
+	client.cloudsearchConn = cloudsearch.New(c.rateLimitSession(names.CloudSearch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])})))
+	client.cloudsearchdomainConn = cloudsearchdomain.New(c.rateLimitSession(names.CloudSearchDomain, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])})))
+	client.cloudtrailConn = cloudtrail.New(c.rateLimitSession(names.CloudTrail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])})))
+	client.cloudwatchConn = cloudwatch.New(c.rateLimitSession(names.CloudWatch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])})))
+	client.codeartifactConn = codeartifact.New(c.rateLimitSession(names.CodeArtifact, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])})))
+	client.codebuildConn = codebuild.New(c.rateLimitSession(names.CodeBuild, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])})))
+	client.codecommitConn = codecommit.New(c.rateLimitSession(names.CodeCommit, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])})))
+	client.codeguruprofilerConn = codeguruprofiler.New(c.rateLimitSession(names.CodeGuruProfiler, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])})))
+	client.codegurureviewerConn = codegurureviewer.New(c.rateLimitSession(names.CodeGuruReviewer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])})))
+	client.codepipelineConn = codepipeline.New(c.rateLimitSession(names.CodePipeline, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])})))
+	client.codestarConn = codestar.New(c.rateLimitSession(names.CodeStar, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])})))
+	client.codestarconnectionsConn = codestarconnections.New(c.rateLimitSession(names.CodeStarConnections, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])})))
+	client.codestarnotificationsConn = codestarnotifications.New(c.rateLimitSession(names.CodeStarNotifications, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])})))
+	client.cognitoidpConn = cognitoidentityprovider.New(c.rateLimitSession(names.CognitoIDP, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])})))
+	client.cognitoidentityConn = cognitoidentity.New(c.rateLimitSession(names.CognitoIdentity, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])})))
+	client.cognitosyncConn = cognitosync.New(c.rateLimitSession(names.CognitoSync, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])})))
+	client.comprehendmedicalConn = comprehendmedical.New(c.rateLimitSession(names.ComprehendMedical, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])})))
+	client.configserviceConn = configservice.New(c.rateLimitSession(names.ConfigService, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])})))
+	client.connectConn = connect.New(c.rateLimitSession(names.Connect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])})))
+	client.connectcontactlensConn = connectcontactlens.New(c.rateLimitSession(names.ConnectContactLens, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])})))
+	client.connectparticipantConn = connectparticipant.New(c.rateLimitSession(names.ConnectParticipant, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])})))
+	client.controltowerConn = controltower.New(c.rateLimitSession(names.ControlTower, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])})))
+	client.customerprofilesConn = customerprofiles.New(c.rateLimitSession(names.CustomerProfiles, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])})))
+	client.daxConn = dax.New(c.rateLimitSession(names.DAX, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])})))
+	client.dlmConn = dlm.New(c.rateLimitSession(names.DLM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])})))
+	client.dmsConn = databasemigrationservice.New(c.rateLimitSession(names.DMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])})))
+	client.drsConn = drs.New(c.rateLimitSession(names.DRS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])})))
+	client.dsConn = directoryservice.New(c.rateLimitSession(names.DS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])})))
+	client.databrewConn = gluedatabrew.New(c.rateLimitSession(names.DataBrew, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])})))
+	client.dataexchangeConn = dataexchange.New(c.rateLimitSession(names.DataExchange, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])})))
+	client.datapipelineConn = datapipeline.New(c.rateLimitSession(names.DataPipeline, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])})))
+	client.datasyncConn = datasync.New(c.rateLimitSession(names.DataSync, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])})))
+	client.deployConn = codedeploy.New(c.rateLimitSession(names.Deploy, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])})))
+	client.detectiveConn = detective.New(c.rateLimitSession(names.Detective, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])})))
+	client.devopsguruConn = devopsguru.New(c.rateLimitSession(names.DevOpsGuru, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])})))
+	client.devicefarmConn = devicefarm.New(c.rateLimitSession(names.DeviceFarm, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])})))
+	client.directconnectConn = directconnect.New(c.rateLimitSession(names.DirectConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])})))
+	client.discoveryConn = applicationdiscoveryservice.New(c.rateLimitSession(names.Discovery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])})))
+	client.docdbConn = docdb.New(c.rateLimitSession(names.DocDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])})))
+	client.dynamodbConn = dynamodb.New(c.rateLimitSession(names.DynamoDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])})))
+	client.dynamodbstreamsConn = dynamodbstreams.New(c.rateLimitSession(names.DynamoDBStreams, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])})))
+	client.ebsConn = ebs.New(c.rateLimitSession(names.EBS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])})))
+	client.ec2Conn = ec2.New(c.rateLimitSession(names.EC2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])})))
+	client.ec2instanceconnectConn = ec2instanceconnect.New(c.rateLimitSession(names.EC2InstanceConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])})))
+	client.ecrConn = ecr.New(c.rateLimitSession(names.ECR, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])})))
+	client.ecrpublicConn = ecrpublic.New(c.rateLimitSession(names.ECRPublic, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])})))
+	client.ecsConn = ecs.New(c.rateLimitSession(names.ECS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])})))
+	client.efsConn = efs.New(c.rateLimitSession(names.EFS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])})))
+	client.eksConn = eks.New(c.rateLimitSession(names.EKS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])})))
+	client.elbConn = elb.New(c.rateLimitSession(names.ELB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])})))
+	client.elbv2Conn = elbv2.New(c.rateLimitSession(names.ELBV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])})))
+	client.emrConn = emr.New(c.rateLimitSession(names.EMR, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])})))
+	client.emrcontainersConn = emrcontainers.New(c.rateLimitSession(names.EMRContainers, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])})))
+	client.emrserverlessConn = emrserverless.New(c.rateLimitSession(names.EMRServerless, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])})))
+	client.elasticacheConn = elasticache.New(c.rateLimitSession(names.ElastiCache, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])})))
+	client.elasticbeanstalkConn = elasticbeanstalk.New(c.rateLimitSession(names.ElasticBeanstalk, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])})))
+	client.elasticinferenceConn = elasticinference.New(c.rateLimitSession(names.ElasticInference, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])})))
+	client.elastictranscoderConn = elastictranscoder.New(c.rateLimitSession(names.ElasticTranscoder, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])})))
+	client.esConn = elasticsearchservice.New(c.rateLimitSession(names.Elasticsearch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])})))
+	client.eventsConn = eventbridge.New(c.rateLimitSession(names.Events, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])})))
+	client.evidentlyConn = cloudwatchevidently.New(c.rateLimitSession(names.Evidently, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])})))
+	client.fmsConn = fms.New(c.rateLimitSession(names.FMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])})))
+	client.fsxConn = fsx.New(c.rateLimitSession(names.FSx, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])})))
+	client.finspaceConn = finspace.New(c.rateLimitSession(names.FinSpace, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])})))
+	client.finspacedataConn = finspacedata.New(c.rateLimitSession(names.FinSpaceData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])})))
+	client.firehoseConn = firehose.New(c.rateLimitSession(names.Firehose, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])})))
+	client.forecastConn = forecastservice.New(c.rateLimitSession(names.Forecast, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])})))
+	client.forecastqueryConn = forecastqueryservice.New(c.rateLimitSession(names.ForecastQuery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])})))
+	client.frauddetectorConn = frauddetector.New(c.rateLimitSession(names.FraudDetector, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])})))
+	client.gameliftConn = gamelift.New(c.rateLimitSession(names.GameLift, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])})))
+	client.glacierConn = glacier.New(c.rateLimitSession(names.Glacier, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])})))
+	client.glueConn = glue.New(c.rateLimitSession(names.Glue, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])})))
+	client.grafanaConn = managedgrafana.New(c.rateLimitSession(names.Grafana, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])})))
+	client.greengrassConn = greengrass.New(c.rateLimitSession(names.Greengrass, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])})))
+	client.greengrassv2Conn = greengrassv2.New(c.rateLimitSession(names.GreengrassV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])})))
+	client.groundstationConn = groundstation.New(c.rateLimitSession(names.GroundStation, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])})))
+	client.guarddutyConn = guardduty.New(c.rateLimitSession(names.GuardDuty, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])})))
+	client.healthConn = health.New(c.rateLimitSession(names.Health, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])})))
+	client.honeycodeConn = honeycode.New(c.rateLimitSession(names.Honeycode, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])})))
+	client.iamConn = iam.New(c.rateLimitSession(names.IAM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])})))
+	client.ivsConn = ivs.New(c.rateLimitSession(names.IVS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])})))
+	client.imagebuilderConn = imagebuilder.New(c.rateLimitSession(names.ImageBuilder, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])})))
+	client.inspectorConn = inspector.New(c.rateLimitSession(names.Inspector, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])})))
+	client.internetmonitorConn = internetmonitor.New(c.rateLimitSession(names.InternetMonitor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.InternetMonitor])})))
+	client.iotConn = iot.New(c.rateLimitSession(names.IoT, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])})))
+	client.iot1clickdevicesConn = iot1clickdevicesservice.New(c.rateLimitSession(names.IoT1ClickDevices, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])})))
+	client.iot1clickprojectsConn = iot1clickprojects.New(c.rateLimitSession(names.IoT1ClickProjects, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])})))
+	client.iotanalyticsConn = iotanalytics.New(c.rateLimitSession(names.IoTAnalytics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])})))
+	client.iotdataConn = iotdataplane.New(c.rateLimitSession(names.IoTData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])})))
+	client.iotdeviceadvisorConn = iotdeviceadvisor.New(c.rateLimitSession(names.IoTDeviceAdvisor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])})))
+	client.ioteventsConn = iotevents.New(c.rateLimitSession(names.IoTEvents, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])})))
+	client.ioteventsdataConn = ioteventsdata.New(c.rateLimitSession(names.IoTEventsData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])})))
+	client.iotfleethubConn = iotfleethub.New(c.rateLimitSession(names.IoTFleetHub, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])})))
+	client.iotjobsdataConn = iotjobsdataplane.New(c.rateLimitSession(names.IoTJobsData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])})))
+	client.iotsecuretunnelingConn = iotsecuretunneling.New(c.rateLimitSession(names.IoTSecureTunneling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])})))
+	client.iotsitewiseConn = iotsitewise.New(c.rateLimitSession(names.IoTSiteWise, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])})))
+	client.iotthingsgraphConn = iotthingsgraph.New(c.rateLimitSession(names.IoTThingsGraph, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])})))
+	client.iottwinmakerConn = iottwinmaker.New(c.rateLimitSession(names.IoTTwinMaker, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])})))
+	client.iotwirelessConn = iotwireless.New(c.rateLimitSession(names.IoTWireless, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])})))
+	client.kmsConn = kms.New(c.rateLimitSession(names.KMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])})))
+	client.kafkaConn = kafka.New(c.rateLimitSession(names.Kafka, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])})))
+	client.kafkaconnectConn = kafkaconnect.New(c.rateLimitSession(names.KafkaConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])})))
+	client.keyspacesConn = keyspaces.New(c.rateLimitSession(names.Keyspaces, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])})))
+	client.kinesisConn = kinesis.New(c.rateLimitSession(names.Kinesis, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])})))
+	client.kinesisanalyticsConn = kinesisanalytics.New(c.rateLimitSession(names.KinesisAnalytics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])})))
+	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(c.rateLimitSession(names.KinesisAnalyticsV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])})))
+	client.kinesisvideoConn = kinesisvideo.New(c.rateLimitSession(names.KinesisVideo, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])})))
+	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(c.rateLimitSession(names.KinesisVideoArchivedMedia, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])})))
+	client.kinesisvideomediaConn = kinesisvideomedia.New(c.rateLimitSession(names.KinesisVideoMedia, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])})))
+	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(c.rateLimitSession(names.KinesisVideoSignaling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])})))
+	client.lakeformationConn = lakeformation.New(c.rateLimitSession(names.LakeFormation, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])})))
+	client.lambdaConn = lambda.New(c.rateLimitSession(names.Lambda, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])})))
+	client.lexmodelsConn = lexmodelbuildingservice.New(c.rateLimitSession(names.LexModels, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])})))
+	client.lexmodelsv2Conn = lexmodelsv2.New(c.rateLimitSession(names.LexModelsV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])})))
+	client.lexruntimeConn = lexruntimeservice.New(c.rateLimitSession(names.LexRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])})))
+	client.lexruntimev2Conn = lexruntimev2.New(c.rateLimitSession(names.LexRuntimeV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])})))
+	client.licensemanagerConn = licensemanager.New(c.rateLimitSession(names.LicenseManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])})))
+	client.lightsailConn = lightsail.New(c.rateLimitSession(names.Lightsail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])})))
+	client.locationConn = locationservice.New(c.rateLimitSession(names.Location, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])})))
+	client.logsConn = cloudwatchlogs.New(c.rateLimitSession(names.Logs, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])})))
+	client.lookoutequipmentConn = lookoutequipment.New(c.rateLimitSession(names.LookoutEquipment, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])})))
+	client.lookoutmetricsConn = lookoutmetrics.New(c.rateLimitSession(names.LookoutMetrics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])})))
+	client.lookoutvisionConn = lookoutforvision.New(c.rateLimitSession(names.LookoutVision, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])})))
+	client.mqConn = mq.New(c.rateLimitSession(names.MQ, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])})))
+	client.mturkConn = mturk.New(c.rateLimitSession(names.MTurk, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])})))
+	client.mwaaConn = mwaa.New(c.rateLimitSession(names.MWAA, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])})))
+	client.machinelearningConn = machinelearning.New(c.rateLimitSession(names.MachineLearning, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])})))
+	client.macieConn = macie.New(c.rateLimitSession(names.Macie, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])})))
+	client.macie2Conn = macie2.New(c.rateLimitSession(names.Macie2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])})))
+	client.managedblockchainConn = managedblockchain.New(c.rateLimitSession(names.ManagedBlockchain, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])})))
+	client.marketplacecatalogConn = marketplacecatalog.New(c.rateLimitSession(names.MarketplaceCatalog, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])})))
+	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(c.rateLimitSession(names.MarketplaceCommerceAnalytics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])})))
+	client.marketplaceentitlementConn = marketplaceentitlementservice.New(c.rateLimitSession(names.MarketplaceEntitlement, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])})))
+	client.marketplacemeteringConn = marketplacemetering.New(c.rateLimitSession(names.MarketplaceMetering, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])})))
+	client.mediaconnectConn = mediaconnect.New(c.rateLimitSession(names.MediaConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])})))
+	client.mediaconvertConn = mediaconvert.New(c.rateLimitSession(names.MediaConvert, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])})))
+	client.mediapackageConn = mediapackage.New(c.rateLimitSession(names.MediaPackage, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])})))
+	client.mediapackagevodConn = mediapackagevod.New(c.rateLimitSession(names.MediaPackageVOD, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])})))
+	client.mediastoreConn = mediastore.New(c.rateLimitSession(names.MediaStore, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])})))
+	client.mediastoredataConn = mediastoredata.New(c.rateLimitSession(names.MediaStoreData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])})))
+	client.mediatailorConn = mediatailor.New(c.rateLimitSession(names.MediaTailor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])})))
+	client.memorydbConn = memorydb.New(c.rateLimitSession(names.MemoryDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])})))
+	client.mghConn = migrationhub.New(c.rateLimitSession(names.MgH, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])})))
+	client.mgnConn = mgn.New(c.rateLimitSession(names.Mgn, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])})))
+	client.migrationhubconfigConn = migrationhubconfig.New(c.rateLimitSession(names.MigrationHubConfig, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])})))
+	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(c.rateLimitSession(names.MigrationHubRefactorSpaces, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])})))
+	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(c.rateLimitSession(names.MigrationHubStrategy, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])})))
+	client.mobileConn = mobile.New(c.rateLimitSession(names.Mobile, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])})))
+	client.neptuneConn = neptune.New(c.rateLimitSession(names.Neptune, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])})))
+	client.networkfirewallConn = networkfirewall.New(c.rateLimitSession(names.NetworkFirewall, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])})))
+	client.networkmanagerConn = networkmanager.New(c.rateLimitSession(names.NetworkManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])})))
+	client.nimbleConn = nimblestudio.New(c.rateLimitSession(names.Nimble, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])})))
+	client.opensearchConn = opensearchservice.New(c.rateLimitSession(names.OpenSearch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])})))
+	client.opsworksConn = opsworks.New(c.rateLimitSession(names.OpsWorks, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])})))
+	client.opsworkscmConn = opsworkscm.New(c.rateLimitSession(names.OpsWorksCM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])})))
+	client.organizationsConn = organizations.New(c.rateLimitSession(names.Organizations, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])})))
+	client.outpostsConn = outposts.New(c.rateLimitSession(names.Outposts, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])})))
+	client.piConn = pi.New(c.rateLimitSession(names.PI, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])})))
+	client.panoramaConn = panorama.New(c.rateLimitSession(names.Panorama, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])})))
+	client.personalizeConn = personalize.New(c.rateLimitSession(names.Personalize, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])})))
+	client.personalizeeventsConn = personalizeevents.New(c.rateLimitSession(names.PersonalizeEvents, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])})))
+	client.personalizeruntimeConn = personalizeruntime.New(c.rateLimitSession(names.PersonalizeRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])})))
+	client.pinpointConn = pinpoint.New(c.rateLimitSession(names.Pinpoint, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])})))
+	client.pinpointemailConn = pinpointemail.New(c.rateLimitSession(names.PinpointEmail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])})))
+	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(c.rateLimitSession(names.PinpointSMSVoice, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])})))
+	client.pollyConn = polly.New(c.rateLimitSession(names.Polly, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])})))
+	client.pricingConn = pricing.New(c.rateLimitSession(names.Pricing, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])})))
+	client.protonConn = proton.New(c.rateLimitSession(names.Proton, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])})))
+	client.qldbConn = qldb.New(c.rateLimitSession(names.QLDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])})))
+	client.qldbsessionConn = qldbsession.New(c.rateLimitSession(names.QLDBSession, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])})))
+	client.quicksightConn = quicksight.New(c.rateLimitSession(names.QuickSight, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])})))
+	client.ramConn = ram.New(c.rateLimitSession(names.RAM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])})))
+	client.rdsConn = rds.New(c.rateLimitSession(names.RDS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])})))
+	client.rdsdataConn = rdsdataservice.New(c.rateLimitSession(names.RDSData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])})))
+	client.rumConn = cloudwatchrum.New(c.rateLimitSession(names.RUM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])})))
+	client.redshiftConn = redshift.New(c.rateLimitSession(names.Redshift, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])})))
+	client.redshiftdataConn = redshiftdataapiservice.New(c.rateLimitSession(names.RedshiftData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])})))
+	client.redshiftserverlessConn = redshiftserverless.New(c.rateLimitSession(names.RedshiftServerless, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])})))
+	client.rekognitionConn = rekognition.New(c.rateLimitSession(names.Rekognition, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])})))
+	client.resiliencehubConn = resiliencehub.New(c.rateLimitSession(names.ResilienceHub, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])})))
+	client.resourcegroupsConn = resourcegroups.New(c.rateLimitSession(names.ResourceGroups, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])})))
+	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(c.rateLimitSession(names.ResourceGroupsTaggingAPI, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])})))
+	client.robomakerConn = robomaker.New(c.rateLimitSession(names.RoboMaker, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])})))
+	client.route53recoveryclusterConn = route53recoverycluster.New(c.rateLimitSession(names.Route53RecoveryCluster, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])})))
+	client.route53resolverConn = route53resolver.New(c.rateLimitSession(names.Route53Resolver, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])})))
+	client.s3controlConn = s3control.New(c.rateLimitSession(names.S3Control, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])})))
+	client.s3outpostsConn = s3outposts.New(c.rateLimitSession(names.S3Outposts, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])})))
+	client.sesConn = ses.New(c.rateLimitSession(names.SES, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])})))
+	client.sfnConn = sfn.New(c.rateLimitSession(names.SFN, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])})))
+	client.smsConn = sms.New(c.rateLimitSession(names.SMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])})))
+	client.snsConn = sns.New(c.rateLimitSession(names.SNS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])})))
+	client.sqsConn = sqs.New(c.rateLimitSession(names.SQS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])})))
+	client.ssmConn = ssm.New(c.rateLimitSession(names.SSM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])})))
+	client.ssoConn = sso.New(c.rateLimitSession(names.SSO, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])})))
+	client.ssoadminConn = ssoadmin.New(c.rateLimitSession(names.SSOAdmin, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])})))
+	client.ssooidcConn = ssooidc.New(c.rateLimitSession(names.SSOOIDC, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])})))
+	client.swfConn = swf.New(c.rateLimitSession(names.SWF, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])})))
+	client.sagemakerConn = sagemaker.New(c.rateLimitSession(names.SageMaker, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])})))
+	client.sagemakera2iruntimeConn = augmentedairuntime.New(c.rateLimitSession(names.SageMakerA2IRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])})))
+	client.sagemakeredgeConn = sagemakeredgemanager.New(c.rateLimitSession(names.SageMakerEdge, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])})))
+	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(c.rateLimitSession(names.SageMakerFeatureStoreRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])})))
+	client.sagemakerruntimeConn = sagemakerruntime.New(c.rateLimitSession(names.SageMakerRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])})))
+	client.savingsplansConn = savingsplans.New(c.rateLimitSession(names.SavingsPlans, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])})))
+	client.schemasConn = schemas.New(c.rateLimitSession(names.Schemas, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])})))
+	client.secretsmanagerConn = secretsmanager.New(c.rateLimitSession(names.SecretsManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])})))
+	client.securityhubConn = securityhub.New(c.rateLimitSession(names.SecurityHub, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])})))
+	client.serverlessrepoConn = serverlessapplicationrepository.New(c.rateLimitSession(names.ServerlessRepo, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])})))
+	client.servicecatalogConn = servicecatalog.New(c.rateLimitSession(names.ServiceCatalog, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])})))
+	client.servicecatalogappregistryConn = appregistry.New(c.rateLimitSession(names.ServiceCatalogAppRegistry, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])})))
+	client.servicediscoveryConn = servicediscovery.New(c.rateLimitSession(names.ServiceDiscovery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])})))
+	client.servicequotasConn = servicequotas.New(c.rateLimitSession(names.ServiceQuotas, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])})))
+	client.signerConn = signer.New(c.rateLimitSession(names.Signer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])})))
+	client.sdbConn = simpledb.New(c.rateLimitSession(names.SimpleDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])})))
+	client.snowdevicemanagementConn = snowdevicemanagement.New(c.rateLimitSession(names.SnowDeviceManagement, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])})))
+	client.snowballConn = snowball.New(c.rateLimitSession(names.Snowball, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])})))
+	client.storagegatewayConn = storagegateway.New(c.rateLimitSession(names.StorageGateway, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])})))
+	client.supportConn = support.New(c.rateLimitSession(names.Support, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])})))
+	client.syntheticsConn = synthetics.New(c.rateLimitSession(names.Synthetics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])})))
+	client.textractConn = textract.New(c.rateLimitSession(names.Textract, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])})))
+	client.timestreamqueryConn = timestreamquery.New(c.rateLimitSession(names.TimestreamQuery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])})))
+	client.timestreamwriteConn = timestreamwrite.New(c.rateLimitSession(names.TimestreamWrite, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])})))
+	client.transcribestreamingConn = transcribestreamingservice.New(c.rateLimitSession(names.TranscribeStreaming, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])})))
+	client.transferConn = transfer.New(c.rateLimitSession(names.Transfer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])})))
+	client.translateConn = translate.New(c.rateLimitSession(names.Translate, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])})))
+	client.voiceidConn = voiceid.New(c.rateLimitSession(names.VoiceID, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])})))
+	client.wafConn = waf.New(c.rateLimitSession(names.WAF, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])})))
+	client.wafregionalConn = wafregional.New(c.rateLimitSession(names.WAFRegional, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])})))
+	client.wafv2Conn = wafv2.New(c.rateLimitSession(names.WAFV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])})))
+	client.wellarchitectedConn = wellarchitected.New(c.rateLimitSession(names.WellArchitected, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])})))
+	client.wisdomConn = connectwisdomservice.New(c.rateLimitSession(names.Wisdom, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])})))
+	client.workdocsConn = workdocs.New(c.rateLimitSession(names.WorkDocs, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])})))
+	client.worklinkConn = worklink.New(c.rateLimitSession(names.WorkLink, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])})))
+	client.workmailConn = workmail.New(c.rateLimitSession(names.WorkMail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])})))
+	client.workmailmessageflowConn = workmailmessageflow.New(c.rateLimitSession(names.WorkMailMessageFlow, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])})))
+	client.workspacesConn = workspaces.New(c.rateLimitSession(names.WorkSpaces, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])})))
+	client.workspaceswebConn = workspacesweb.New(c.rateLimitSession(names.WorkSpacesWeb, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])})))
+	client.xrayConn = xray.New(c.rateLimitSession(names.XRay, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])})))
 }
 
 // sdkv2Conns initializes AWS SDK for Go v2 clients.
@@ -614,136 +614,163 @@ func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
 		if endpoint := c.Endpoints[names.Account]; endpoint != "" {
 			o.EndpointResolver = account.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Account)...)
 	})
 	client.auditmanagerClient = auditmanager.NewFromConfig(cfg, func(o *auditmanager.Options) {
 		if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
 			o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.AuditManager)...)
 	})
 	client.cleanroomsClient = cleanrooms.NewFromConfig(cfg, func(o *cleanrooms.Options) {
 		if endpoint := c.Endpoints[names.CleanRooms]; endpoint != "" {
 			o.EndpointResolver = cleanrooms.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.CleanRooms)...)
 	})
 	client.cloudcontrolClient = cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
 		if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
 			o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.CloudControl)...)
 	})
 	client.comprehendClient = comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
 		if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
 			o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Comprehend)...)
 	})
 	client.computeoptimizerClient = computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
 		if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
 			o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.ComputeOptimizer)...)
 	})
 	client.docdbelasticClient = docdbelastic.NewFromConfig(cfg, func(o *docdbelastic.Options) {
 		if endpoint := c.Endpoints[names.DocDBElastic]; endpoint != "" {
 			o.EndpointResolver = docdbelastic.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.DocDBElastic)...)
 	})
 	client.fisClient = fis.NewFromConfig(cfg, func(o *fis.Options) {
 		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
 			o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.FIS)...)
 	})
 	client.healthlakeClient = healthlake.NewFromConfig(cfg, func(o *healthlake.Options) {
 		if endpoint := c.Endpoints[names.HealthLake]; endpoint != "" {
 			o.EndpointResolver = healthlake.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.HealthLake)...)
 	})
 	client.ivschatClient = ivschat.NewFromConfig(cfg, func(o *ivschat.Options) {
 		if endpoint := c.Endpoints[names.IVSChat]; endpoint != "" {
 			o.EndpointResolver = ivschat.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.IVSChat)...)
 	})
 	client.identitystoreClient = identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
 		if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
 			o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.IdentityStore)...)
 	})
 	client.inspector2Client = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
 		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
 			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Inspector2)...)
 	})
 	client.kendraClient = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
 		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
 			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Kendra)...)
 	})
 	client.medialiveClient = medialive.NewFromConfig(cfg, func(o *medialive.Options) {
 		if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
 			o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.MediaLive)...)
 	})
 	client.oamClient = oam.NewFromConfig(cfg, func(o *oam.Options) {
 		if endpoint := c.Endpoints[names.ObservabilityAccessManager]; endpoint != "" {
 			o.EndpointResolver = oam.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.ObservabilityAccessManager)...)
 	})
 	client.opensearchserverlessClient = opensearchserverless.NewFromConfig(cfg, func(o *opensearchserverless.Options) {
 		if endpoint := c.Endpoints[names.OpenSearchServerless]; endpoint != "" {
 			o.EndpointResolver = opensearchserverless.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.OpenSearchServerless)...)
 	})
 	client.pipesClient = pipes.NewFromConfig(cfg, func(o *pipes.Options) {
 		if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
 			o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Pipes)...)
 	})
 	client.rbinClient = rbin.NewFromConfig(cfg, func(o *rbin.Options) {
 		if endpoint := c.Endpoints[names.RBin]; endpoint != "" {
 			o.EndpointResolver = rbin.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.RBin)...)
 	})
 	client.resourceexplorer2Client = resourceexplorer2.NewFromConfig(cfg, func(o *resourceexplorer2.Options) {
 		if endpoint := c.Endpoints[names.ResourceExplorer2]; endpoint != "" {
 			o.EndpointResolver = resourceexplorer2.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.ResourceExplorer2)...)
 	})
 	client.rolesanywhereClient = rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
 		if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
 			o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.RolesAnywhere)...)
 	})
 	client.sesv2Client = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
 		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
 			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.SESV2)...)
 	})
 	client.ssmcontactsClient = ssmcontacts.NewFromConfig(cfg, func(o *ssmcontacts.Options) {
 		if endpoint := c.Endpoints[names.SSMContacts]; endpoint != "" {
 			o.EndpointResolver = ssmcontacts.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.SSMContacts)...)
 	})
 	client.ssmincidentsClient = ssmincidents.NewFromConfig(cfg, func(o *ssmincidents.Options) {
 		if endpoint := c.Endpoints[names.SSMIncidents]; endpoint != "" {
 			o.EndpointResolver = ssmincidents.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.SSMIncidents)...)
 	})
 	client.schedulerClient = scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
 		if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
 			o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Scheduler)...)
 	})
 	client.securitylakeClient = securitylake.NewFromConfig(cfg, func(o *securitylake.Options) {
 		if endpoint := c.Endpoints[names.SecurityLake]; endpoint != "" {
 			o.EndpointResolver = securitylake.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.SecurityLake)...)
 	})
 	client.transcribeClient = transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
 		if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
 			o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Transcribe)...)
 	})
 	client.vpclatticeClient = vpclattice.NewFromConfig(cfg, func(o *vpclattice.Options) {
 		if endpoint := c.Endpoints[names.VPCLattice]; endpoint != "" {
 			o.EndpointResolver = vpclattice.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.VPCLattice)...)
 	})
 }
 
@@ -754,6 +781,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.DS]; endpoint != "" {
 				o.EndpointResolver = directoryservice_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.DS)...)
 		})
 	})
 	client.ec2Client.init(&cfg, func() *ec2_sdkv2.Client {
@@ -761,6 +789,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
 				o.EndpointResolver = ec2_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.EC2)...)
 		})
 	})
 	client.lambdaClient.init(&cfg, func() *lambda_sdkv2.Client {
@@ -768,6 +797,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.Lambda]; endpoint != "" {
 				o.EndpointResolver = lambda_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Lambda)...)
 		})
 	})
 	client.logsClient.init(&cfg, func() *cloudwatchlogs_sdkv2.Client {
@@ -775,6 +805,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.Logs]; endpoint != "" {
 				o.EndpointResolver = cloudwatchlogs_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.Logs)...)
 		})
 	})
 	client.rdsClient.init(&cfg, func() *rds_sdkv2.Client {
@@ -782,6 +813,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.RDS]; endpoint != "" {
 				o.EndpointResolver = rds_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.RDS)...)
 		})
 	})
 	client.s3controlClient.init(&cfg, func() *s3control_sdkv2.Client {
@@ -789,6 +821,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.S3Control]; endpoint != "" {
 				o.EndpointResolver = s3control_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.S3Control)...)
 		})
 	})
 	client.ssmClient.init(&cfg, func() *ssm_sdkv2.Client {
@@ -796,6 +829,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.SSM]; endpoint != "" {
 				o.EndpointResolver = ssm_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, c.rateLimitAPIOptions(names.SSM)...)
 		})
 	})
 }
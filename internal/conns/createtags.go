@@ -0,0 +1,45 @@
+package conns
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// createTagsSessionHandlers adds handlers that set a resource's tags in the request that creates the resource to an AWS SDK for Go v1 session.
+// The API clients created from the session inherit the handlers.
+func createTagsSessionHandlers(sess *session.Session) {
+	// Validate handlers are run before the request is built.
+	sess.Handlers.Validate.PushFront(func(r *request.Request) {
+		if err := tftags.SetCreateTags(r.Context(), r.Operation.Name, r.Params); err != nil {
+			r.Error = err
+		}
+	})
+}
+
+// createTagsAPIOptions returns AWS SDK for Go v2 API options that set a resource's tags in the request that creates the resource.
+func createTagsAPIOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(&createTagsMiddleware{}, middleware.After)
+		},
+	}
+}
+
+type createTagsMiddleware struct{}
+
+func (m *createTagsMiddleware) ID() string {
+	return "TerraformAWSProviderCreateTags"
+}
+
+func (m *createTagsMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if err := tftags.SetCreateTags(ctx, awsmiddleware.GetOperationName(ctx), in.Parameters); err != nil {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, err
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,319 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/mitchellh/go-homedir"
+)
+
+// Sources of the initial credentials in the credentials chain.
+const (
+	CredentialsSourceAssumeRole    = "assume_role"
+	CredentialsSourceContainer     = "container"
+	CredentialsSourceEnvironment   = "environment"
+	CredentialsSourceIMDS          = "imds"
+	CredentialsSourceProcess       = "process"
+	CredentialsSourceSharedProfile = "shared_profile"
+	CredentialsSourceSSO           = "sso"
+	CredentialsSourceStatic        = "static"
+	CredentialsSourceUnknown       = "unknown"
+	CredentialsSourceWebIdentity   = "web_identity"
+)
+
+// credentialsSources maps AWS SDK for Go v2 credentials provider names to credentials chain sources.
+var credentialsSources = map[string]string{
+	config.CredentialsSourceName:      CredentialsSourceEnvironment,
+	credentials.StaticCredentialsName: CredentialsSourceStatic,
+	ec2rolecreds.ProviderName:         CredentialsSourceIMDS,
+	endpointcreds.ProviderName:        CredentialsSourceContainer,
+	processcreds.ProviderName:         CredentialsSourceProcess,
+	ssocreds.ProviderName:             CredentialsSourceSSO,
+	stscreds.ProviderName:             CredentialsSourceAssumeRole,
+	stscreds.WebIdentityProviderName:  CredentialsSourceWebIdentity,
+}
+
+func credentialsSource(providerName string) string {
+	// Static credentials from a shared credentials or config file.
+	if strings.HasPrefix(providerName, "SharedConfigCredentials") {
+		return CredentialsSourceSharedProfile
+	}
+
+	if v, ok := credentialsSources[providerName]; ok {
+		return v
+	}
+
+	return CredentialsSourceUnknown
+}
+
+// CredentialsChain describes how the provider's AWS credentials were resolved.
+// It never contains secret values.
+type CredentialsChain struct {
+	Source       string    // Source of the initial credentials, e.g. "environment" or "sso"
+	ProviderName string    // Name of the AWS SDK for Go v2 credentials provider of the initial credentials
+	AccountID    string    // Account of the resolved credentials
+	Partition    string    // Partition of the resolved credentials
+	AssumedRoles []string  // ARNs of the IAM roles assumed using the initial credentials, in order
+	CanExpire    bool      // Whether the resolved credentials expire
+	Expires      time.Time // When the resolved credentials expire
+}
+
+// credentialsChainResolver lazily resolves a provider Meta's credentials chain.
+type credentialsChainResolver struct {
+	once          sync.Once
+	awsbaseConfig awsbase.Config
+	assumeRoles   []*awsbase.AssumeRole
+	credentials   aws_sdkv2.CredentialsProvider
+	chain         *CredentialsChain
+	err           error
+}
+
+func newCredentialsChainResolver(awsbaseConfig awsbase.Config, assumeRoles []*awsbase.AssumeRole, cfg aws_sdkv2.Config) *credentialsChainResolver {
+	return &credentialsChainResolver{
+		awsbaseConfig: awsbaseConfig,
+		assumeRoles:   assumeRoles,
+		credentials:   cfg.Credentials,
+	}
+}
+
+func (r *credentialsChainResolver) resolve(ctx context.Context, client *AWSClient) (*CredentialsChain, error) {
+	creds, err := r.credentials.Retrieve(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("retrieving credentials: %w", err)
+	}
+
+	chain := &CredentialsChain{
+		ProviderName: creds.Source,
+		AccountID:    client.AccountID,
+		Partition:    client.Partition,
+		CanExpire:    creds.CanExpire,
+		Expires:      creds.Expires,
+	}
+
+	if v := r.awsbaseConfig.AssumeRoleWithWebIdentity; v != nil && v.RoleARN != "" {
+		chain.AssumedRoles = append(chain.AssumedRoles, v.RoleARN)
+	}
+
+	if v := r.awsbaseConfig.AssumeRole; v != nil && v.RoleARN != "" {
+		for _, ar := range r.assumeRoles {
+			chain.AssumedRoles = append(chain.AssumedRoles, ar.RoleARN)
+		}
+
+		// The resolved credentials are those of the assumed role.
+		// Resolve the initial credentials again, without assuming the role, to find their source.
+		c := r.awsbaseConfig
+		c.AssumeRole = nil
+		c.SkipCredsValidation = true
+
+		_, cfg, err := awsbase.GetAwsConfig(ctx, &c)
+
+		if err != nil {
+			return nil, fmt.Errorf("resolving initial credentials: %w", err)
+		}
+
+		creds, err := cfg.Credentials.Retrieve(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("retrieving initial credentials: %w", err)
+		}
+
+		chain.ProviderName = creds.Source
+	}
+
+	chain.Source = credentialsSource(chain.ProviderName)
+
+	return chain, nil
+}
+
+// CredentialsChain returns a description of how the provider's AWS credentials were resolved.
+func (client *AWSClient) CredentialsChain(ctx context.Context) (*CredentialsChain, error) {
+	r := client.credentialsChainResolver
+
+	if r == nil {
+		return nil, errors.New("provider not configured")
+	}
+
+	r.once.Do(func() {
+		r.chain, r.err = r.resolve(ctx, client)
+	})
+
+	return r.chain, r.err
+}
+
+// credentialsDiagnosticsDetail returns a description of the credential sources that are checked, in order, for use in diagnostics.
+// It never contains secret values.
+func credentialsDiagnosticsDetail(ctx context.Context, c *Config) string {
+	var lines []string
+	add := func(format string, a ...any) {
+		lines = append(lines, "- "+fmt.Sprintf(format, a...))
+	}
+	isSet := func(b bool) string {
+		if b {
+			return "set"
+		}
+		return "not set"
+	}
+
+	add("Provider configuration access_key and secret_key: %s", isSet(c.AccessKey != "" && c.SecretKey != ""))
+	add("Environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY: %s", isSet(os.Getenv("AWS_ACCESS_KEY_ID") != "" && os.Getenv("AWS_SECRET_ACCESS_KEY") != ""))
+
+	profile, profileSource := c.Profile, "provider configuration"
+	if profile == "" {
+		if v := os.Getenv("AWS_PROFILE"); v != "" {
+			profile, profileSource = v, "environment variable AWS_PROFILE"
+		} else {
+			profile, profileSource = "default", "default"
+		}
+	}
+	add("Shared configuration profile %q (from %s): %s", profile, profileSource, sharedConfigProfileDetail(ctx, c, profile))
+
+	add("Web identity (environment variables AWS_WEB_IDENTITY_TOKEN_FILE and AWS_ROLE_ARN): %s", webIdentityDetail(c))
+	add("Container credentials (environment variables AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI): %s", isSet(os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI") != "" || os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI") != ""))
+
+	if c.EC2MetadataServiceEnableState == imds.ClientDisabled || strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true") {
+		add("EC2 instance metadata service: disabled")
+	} else {
+		add("EC2 instance metadata service: enabled")
+	}
+
+	for i, v := range c.AssumeRole {
+		if v.RoleARN == "" {
+			continue
+		}
+
+		if i == 0 {
+			add("Role assumed using the resolved credentials: %s", v.RoleARN)
+		} else {
+			add("Role assumed using the credentials of the previous role: %s", v.RoleARN)
+		}
+	}
+
+	return "Credential sources checked, in order:\n" + strings.Join(lines, "\n")
+}
+
+func sharedConfigProfileDetail(ctx context.Context, c *Config, profile string) string {
+	sharedConfig, err := config.LoadSharedConfigProfile(ctx, profile, func(o *config.LoadSharedConfigOptions) {
+		o.ConfigFiles = expandPaths(c.SharedConfigFiles)
+		o.CredentialsFiles = expandPaths(c.SharedCredentialsFiles)
+	})
+
+	if err != nil {
+		var notExist config.SharedConfigProfileNotExistError
+		if errors.As(err, &notExist) {
+			return "not found"
+		}
+
+		return fmt.Sprintf("error loading: %s", err)
+	}
+
+	var details []string
+
+	if sharedConfig.Credentials.HasKeys() {
+		details = append(details, "static keys")
+	}
+	if sharedConfig.RoleARN != "" {
+		details = append(details, fmt.Sprintf("role_arn %s", sharedConfig.RoleARN))
+	}
+	if sharedConfig.CredentialProcess != "" {
+		details = append(details, "credential_process")
+	}
+	if key, startURL := ssoTokenCacheKey(sharedConfig); key != "" {
+		details = append(details, fmt.Sprintf("SSO start URL %s (%s)", startURL, ssoTokenCacheDetail(key)))
+	}
+
+	if len(details) == 0 {
+		return "found, no credentials"
+	}
+
+	return "found, " + strings.Join(details, ", ")
+}
+
+// ssoTokenCacheKey returns the SSO token cache key and start URL for a shared configuration profile, if any.
+func ssoTokenCacheKey(sharedConfig config.SharedConfig) (string, string) {
+	if v := sharedConfig.SSOSession; v != nil {
+		return v.Name, v.SSOStartURL
+	}
+
+	return sharedConfig.SSOStartURL, sharedConfig.SSOStartURL
+}
+
+// ssoTokenCacheDetail describes the cached SSO token for the specified key.
+// Only the token's expiry time is read.
+func ssoTokenCacheDetail(key string) string {
+	path, err := ssocreds.StandardCachedTokenFilepath(key)
+
+	if err != nil {
+		return fmt.Sprintf("token cache error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return "no cached token, run aws sso login"
+	}
+
+	if err != nil {
+		return fmt.Sprintf("token cache error: %s", err)
+	}
+
+	var token struct {
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	if err := json.Unmarshal(b, &token); err != nil {
+		return fmt.Sprintf("token cache error: %s", err)
+	}
+
+	if time.Now().After(token.ExpiresAt) {
+		return fmt.Sprintf("cached token expired at %s, run aws sso login", token.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("cached token expires at %s", token.ExpiresAt.Format(time.RFC3339))
+}
+
+func webIdentityDetail(c *Config) string {
+	if v := c.AssumeRoleWithWebIdentity; v != nil && v.RoleARN != "" {
+		return fmt.Sprintf("set in provider configuration, role %s", v.RoleARN)
+	}
+
+	path, roleARN := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"), os.Getenv("AWS_ROLE_ARN")
+
+	if path == "" || roleARN == "" {
+		return "not set"
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Sprintf("set, role %s, token file not readable: %s", roleARN, err)
+	}
+
+	return fmt.Sprintf("set, role %s", roleARN)
+}
+
+func expandPaths(paths []string) []string {
+	var expanded []string
+
+	for _, v := range paths {
+		if v, err := homedir.Expand(v); err == nil {
+			expanded = append(expanded, v)
+		}
+	}
+
+	return expanded
+}
@@ -0,0 +1,85 @@
+package conns
+
+import (
+	"context"
+	"crypto/sha1" // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-sha1
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCredentialsSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"EnvConfigCredentials":                                 CredentialsSourceEnvironment,
+		"SharedConfigCredentials: /home/user/.aws/credentials": CredentialsSourceSharedProfile,
+		"SSOProvider":            CredentialsSourceSSO,
+		"WebIdentityCredentials": CredentialsSourceWebIdentity,
+		"EC2RoleProvider":        CredentialsSourceIMDS,
+		"Unknown":                CredentialsSourceUnknown,
+	}
+
+	for providerName, expected := range testCases {
+		providerName, expected := providerName, expected
+
+		t.Run(providerName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := credentialsSource(providerName); got != expected {
+				t.Errorf("credentialsSource(%q) = %q, want %q", providerName, got, expected)
+			}
+		})
+	}
+}
+
+func TestCredentialsDiagnosticsDetail(t *testing.T) { //nolint:paralleltest // Uses t.Setenv.
+	ctx := context.Background()
+	home := t.TempDir()
+	startURL := "https://example.awsapps.com/start"
+
+	configFile := filepath.Join(home, "config")
+	if err := os.WriteFile(configFile, []byte("[profile test]\nsso_start_url = "+startURL+"\nsso_region = us-east-1\nsso_account_id = 123456789012\nsso_role_name = Test\n"), 0600); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hash := sha1.Sum([]byte(startURL)) //nolint:gosec // Matches the AWS SDK's SSO token cache file name.
+	cacheDir := filepath.Join(home, ".aws", "sso", "cache")
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	if err := os.WriteFile(filepath.Join(cacheDir, hex.EncodeToString(hash[:])+".json"), []byte(`{"accessToken":"SECRETTOKEN","expiresAt":"`+expiresAt.Format(time.RFC3339)+`"}`), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	t.Setenv("HOME", home)
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIAEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "SECRETKEY")
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "")
+	t.Setenv("AWS_ROLE_ARN", "")
+
+	detail := credentialsDiagnosticsDetail(ctx, &Config{
+		Profile:           "test",
+		SharedConfigFiles: []string{configFile},
+	})
+
+	for _, want := range []string{
+		"Environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY: set",
+		`Shared configuration profile "test" (from provider configuration): found, SSO start URL ` + startURL,
+		"cached token expires at " + expiresAt.Format(time.RFC3339),
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail does not contain %q:\n%s", want, detail)
+		}
+	}
+
+	for _, secret := range []string{"AKIAEXAMPLE", "SECRETKEY", "SECRETTOKEN"} {
+		if strings.Contains(detail, secret) {
+			t.Errorf("detail contains secret %q:\n%s", secret, detail)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// APICall is a single AWS API call, including any retries, recorded in an API call journal.
+type APICall struct {
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	RequestID  string    `json:"request_id,omitempty"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Start      time.Time `json:"start"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+// APICallJournal records the AWS API calls made during a single resource CRUD operation.
+type APICallJournal struct {
+	mu    sync.Mutex
+	calls []APICall
+}
+
+func (j *APICallJournal) record(call APICall) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.calls = append(j.calls, call)
+}
+
+// Calls returns the recorded API calls in the order that they completed.
+func (j *APICallJournal) Calls() []APICall {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return append([]APICall(nil), j.calls...)
+}
+
+type apiCallJournalKeyType int
+
+var apiCallJournalKey apiCallJournalKeyType
+
+// NewAPICallJournalContext returns a Context enhanced with a new, empty API call journal.
+// API calls made using the Context are recorded in the journal.
+func NewAPICallJournalContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiCallJournalKey, &APICallJournal{})
+}
+
+// APICallJournalFromContext returns the API call journal kept in Context, if any.
+func APICallJournalFromContext(ctx context.Context) (*APICallJournal, bool) {
+	v, ok := ctx.Value(apiCallJournalKey).(*APICallJournal)
+	return v, ok
+}
+
+// apiCallJournalSessionHandlers adds handlers that record API calls in any API call journal to an AWS SDK for Go v1 session.
+// The API clients created from the session inherit the handlers.
+func apiCallJournalSessionHandlers(sess *session.Session) {
+	// Complete handlers are run once after the request, including any retries, completes.
+	sess.Handlers.Complete.PushBack(func(r *request.Request) {
+		journal, ok := APICallJournalFromContext(r.Context())
+		if !ok {
+			return
+		}
+
+		call := APICall{
+			Service:    r.ClientInfo.ServiceName,
+			Operation:  r.Operation.Name,
+			RequestID:  r.RequestID,
+			Start:      r.Time,
+			DurationMS: time.Since(r.Time).Milliseconds(),
+		}
+		if r.HTTPResponse != nil {
+			call.StatusCode = r.HTTPResponse.StatusCode
+		}
+		if r.Error != nil {
+			call.Error = r.Error.Error()
+		}
+
+		journal.record(call)
+	})
+}
+
+// apiCallJournalAPIOptions returns AWS SDK for Go v2 API options that record API calls in any API call journal.
+func apiCallJournalAPIOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Run after the service metadata is registered and before any retries.
+			return stack.Initialize.Add(&apiCallJournalMiddleware{}, middleware.After)
+		},
+	}
+}
+
+type apiCallJournalMiddleware struct{}
+
+func (m *apiCallJournalMiddleware) ID() string {
+	return "TerraformAWSProviderAPICallJournal"
+}
+
+func (m *apiCallJournalMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	journal, ok := APICallJournalFromContext(ctx)
+	if !ok {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	call := APICall{
+		Service:    awsmiddleware.GetServiceID(ctx),
+		Operation:  awsmiddleware.GetOperationName(ctx),
+		Start:      start,
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		call.RequestID = v
+	}
+	if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+		call.StatusCode = v.StatusCode
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+
+	journal.record(call)
+
+	return out, metadata, err
+}
+
+// APICallJournalRecord is a single line in an API call journal file.
+type APICallJournalRecord struct {
+	APICall
+	TypeName          string `json:"resource_type"`
+	ID                string `json:"resource_id,omitempty"`
+	ResourceOperation string `json:"resource_operation"`
+}
+
+// APICallJournalWriter appends API call journal records to a file as JSON lines.
+type APICallJournalWriter struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewAPICallJournalWriter returns a writer that appends API call journal records to the specified file.
+func NewAPICallJournalWriter(path string) *APICallJournalWriter {
+	return &APICallJournalWriter{path: path}
+}
+
+// Write appends the specified records to the journal file.
+func (w *APICallJournalWriter) Write(records []APICallJournalRecord) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	f, err := os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return fmt.Errorf("opening API call journal file (%s): %w", w.path, err)
+	}
+
+	enc := json.NewEncoder(f)
+
+	for _, v := range records {
+		if err := enc.Encode(v); err != nil {
+			f.Close()
+
+			return fmt.Errorf("writing API call journal file (%s): %w", w.path, err)
+		}
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,140 @@
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestAPICallJournalMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Journal       bool
+		Err           error
+		ExpectedCalls int
+		ExpectedError string
+	}{
+		{
+			Name: "no journal",
+		},
+		{
+			Name:          "success",
+			Journal:       true,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "error",
+			Journal:       true,
+			Err:           errors.New("failed"),
+			ExpectedCalls: 1,
+			ExpectedError: "failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.Journal {
+				ctx = NewAPICallJournalContext(ctx)
+			}
+
+			next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				var metadata middleware.Metadata
+				awsmiddleware.SetRequestIDMetadata(&metadata, "req-1")
+
+				return middleware.InitializeOutput{}, metadata, testCase.Err
+			})
+
+			m := &apiCallJournalMiddleware{}
+			_, _, err := m.HandleInitialize(ctx, middleware.InitializeInput{}, next)
+
+			if !errors.Is(err, testCase.Err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			journal, ok := APICallJournalFromContext(ctx)
+
+			if !testCase.Journal {
+				if ok {
+					t.Fatal("unexpected journal")
+				}
+
+				return
+			}
+
+			calls := journal.Calls()
+
+			if got, want := len(calls), testCase.ExpectedCalls; got != want {
+				t.Fatalf("got %d calls, want %d", got, want)
+			}
+
+			if got, want := calls[0].RequestID, "req-1"; got != want {
+				t.Errorf("RequestID = %q, want %q", got, want)
+			}
+
+			if got, want := calls[0].Error, testCase.ExpectedError; got != want {
+				t.Errorf("Error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAPICallJournalWriter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	w := NewAPICallJournalWriter(path)
+
+	for _, v := range []string{"CreateThing", "DescribeThing"} {
+		err := w.Write([]APICallJournalRecord{{
+			APICall:           APICall{Service: "test", Operation: v, RequestID: "req-" + v},
+			TypeName:          "aws_test_thing",
+			ID:                "thing-1",
+			ResourceOperation: "Create",
+		}})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer f.Close()
+
+	var operations []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record map[string]any
+
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := record["resource_type"], "aws_test_thing"; got != want {
+			t.Errorf("resource_type = %v, want %v", got, want)
+		}
+
+		operations = append(operations, record["operation"].(string))
+	}
+
+	if got, want := len(operations), 2; got != want {
+		t.Fatalf("got %d records, want %d", got, want)
+	}
+}
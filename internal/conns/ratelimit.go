@@ -0,0 +1,301 @@
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// rateLimitDecreaseFactor is the factor by which the request rate is reduced on throttling.
+	rateLimitDecreaseFactor = 0.5
+	// rateLimitMinimumFraction is the fraction of the configured request rate below which the rate is not reduced.
+	rateLimitMinimumFraction = 0.1
+	// rateLimitDecreaseInterval is the minimum interval between request rate reductions.
+	// Concurrent requests that are throttled together reduce the rate once.
+	rateLimitDecreaseInterval = 1 * time.Second
+	// rateLimitRecoveryPeriod is the period over which a reduced request rate recovers linearly to the configured rate.
+	rateLimitRecoveryPeriod = 1 * time.Minute
+)
+
+// RateLimit is the client-side rate limit for requests to an AWS service, or to a single operation of the service.
+type RateLimit struct {
+	// Service is the provider package name of the service, e.g. "ec2".
+	Service string
+	// Operation is the optional name of the API operation, e.g. "DescribeInstances".
+	Operation string
+	// RequestsPerSecond is the sustained request rate.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests sent at once. Defaults to RequestsPerSecond, rounded up.
+	Burst int
+}
+
+// rateLimiters are the client-side rate limiters of the configured services and operations.
+type rateLimiters struct {
+	services   map[string]*rateLimiter
+	operations map[string]map[string]*rateLimiter
+}
+
+func newRateLimiters(limits []RateLimit) *rateLimiters {
+	l := &rateLimiters{
+		services:   make(map[string]*rateLimiter),
+		operations: make(map[string]map[string]*rateLimiter),
+	}
+
+	for _, limit := range limits {
+		limiter := newRateLimiter(limit.RequestsPerSecond, limit.Burst)
+
+		if limit.Operation == "" {
+			l.services[limit.Service] = limiter
+			continue
+		}
+
+		if l.operations[limit.Service] == nil {
+			l.operations[limit.Service] = make(map[string]*rateLimiter)
+		}
+		l.operations[limit.Service][limit.Operation] = limiter
+	}
+
+	return l
+}
+
+// limited returns whether requests to the specified service are rate limited.
+func (l *rateLimiters) limited(service string) bool {
+	if l == nil {
+		return false
+	}
+
+	return l.services[service] != nil || len(l.operations[service]) > 0
+}
+
+// get returns the rate limiter for the specified service operation, or nil if the operation is not rate limited.
+// An operation's own rate limiter takes precedence over the service's.
+func (l *rateLimiters) get(service, operation string) *rateLimiter {
+	if l == nil {
+		return nil
+	}
+
+	if v, ok := l.operations[service][operation]; ok {
+		return v
+	}
+
+	return l.services[service]
+}
+
+// rateLimiter is an adaptive token bucket rate limiter.
+// The request rate is halved when a request is throttled and then recovers linearly to the configured rate.
+type rateLimiter struct {
+	maxRate float64
+	burst   float64
+
+	mu sync.Mutex
+	// Rate immediately after the last reduction.
+	reducedRate  float64
+	lastDecrease time.Time
+	tokens       float64
+	lastRefill   time.Time
+	// Cumulative wait time.
+	waited time.Duration
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		maxRate: requestsPerSecond,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+	}
+}
+
+// rate returns the request rate at the specified time.
+// The caller must hold the lock.
+func (l *rateLimiter) rate(now time.Time) float64 {
+	if l.lastDecrease.IsZero() {
+		return l.maxRate
+	}
+
+	elapsed := now.Sub(l.lastDecrease)
+	if elapsed >= rateLimitRecoveryPeriod {
+		return l.maxRate
+	}
+
+	return l.reducedRate + (l.maxRate-l.reducedRate)*float64(elapsed)/float64(rateLimitRecoveryPeriod)
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before sending the request,
+// the current request rate and the cumulative wait time.
+func (l *rateLimiter) reserve(now time.Time) (time.Duration, float64, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rate := l.rate(now)
+
+	if !l.lastRefill.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+rate*now.Sub(l.lastRefill).Seconds())
+	}
+	l.lastRefill = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0, rate, l.waited
+	}
+
+	wait := time.Duration(-l.tokens / rate * float64(time.Second))
+	l.waited += wait
+
+	return wait, rate, l.waited
+}
+
+// throttled reduces the request rate following a throttled request.
+// It returns whether the rate was reduced and the new rate.
+func (l *rateLimiter) throttled(now time.Time) (bool, float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rate := l.rate(now)
+
+	if !l.lastDecrease.IsZero() && now.Sub(l.lastDecrease) < rateLimitDecreaseInterval {
+		return false, rate
+	}
+
+	l.reducedRate = math.Max(rate*rateLimitDecreaseFactor, l.maxRate*rateLimitMinimumFraction)
+	l.lastDecrease = now
+
+	return true, l.reducedRate
+}
+
+// wait blocks until a request to the specified service operation may be sent.
+func (l *rateLimiter) wait(ctx context.Context, service, operation string) error {
+	wait, rate, waited := l.reserve(time.Now())
+
+	if wait <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Rate limiting AWS API request", map[string]any{
+		"aws.service":                       service,
+		"aws.operation":                     operation,
+		"rate_limit.requests_per_second":    rate,
+		"rate_limit.wait_duration_ms":       wait.Milliseconds(),
+		"rate_limit.total_wait_duration_ms": waited.Milliseconds(),
+	})
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// observe adapts the request rate to the result of a request.
+func (l *rateLimiter) observe(ctx context.Context, service, operation string, throttled bool) {
+	if !throttled {
+		return
+	}
+
+	if reduced, rate := l.throttled(time.Now()); reduced {
+		tflog.Info(ctx, "Reducing AWS API request rate after throttling", map[string]any{
+			"aws.service":                    service,
+			"aws.operation":                  operation,
+			"rate_limit.requests_per_second": rate,
+		})
+	}
+}
+
+// rateLimitSession adds handlers that rate limit requests to the specified service to an AWS SDK for Go v1 session.
+// The API client created from the session inherits the handlers.
+func (c *Config) rateLimitSession(service string, sess *session.Session) *session.Session {
+	if !c.rateLimiters.limited(service) {
+		return sess
+	}
+
+	limiters := c.rateLimiters
+
+	// Sign handlers are run before each attempt.
+	sess.Handlers.Sign.PushFront(func(r *request.Request) {
+		if l := limiters.get(service, r.Operation.Name); l != nil {
+			if err := l.wait(r.Context(), service, r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		}
+	})
+	sess.Handlers.Retry.PushFront(func(r *request.Request) {
+		if l := limiters.get(service, r.Operation.Name); l != nil {
+			l.observe(r.Context(), service, r.Operation.Name, request.IsErrorThrottle(r.Error))
+		}
+	})
+
+	return sess
+}
+
+// rateLimitAPIOptions returns AWS SDK for Go v2 API options that rate limit requests to the specified service.
+func (c *Config) rateLimitAPIOptions(service string) []func(*middleware.Stack) error {
+	if !c.rateLimiters.limited(service) {
+		return nil
+	}
+
+	m := &rateLimitMiddleware{
+		limiters: c.rateLimiters,
+		service:  service,
+	}
+
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Rate limit each attempt, after the retry middleware.
+			if err := stack.Finalize.Insert(m, "Retry", middleware.After); err != nil {
+				return stack.Finalize.Add(m, middleware.After)
+			}
+
+			return nil
+		},
+	}
+}
+
+type rateLimitMiddleware struct {
+	limiters *rateLimiters
+	service  string
+}
+
+func (m *rateLimitMiddleware) ID() string {
+	return "TerraformAWSProviderRateLimit"
+}
+
+func (m *rateLimitMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	l := m.limiters.get(m.service, operation)
+
+	if l == nil {
+		return next.HandleFinalize(ctx, in)
+	}
+
+	if err := l.wait(ctx, m.service, operation); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err != nil {
+		l.observe(ctx, m.service, operation, retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary)
+	}
+
+	return out, metadata, err
+}
@@ -0,0 +1,164 @@
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimitersGet(t *testing.T) {
+	t.Parallel()
+
+	limiters := newRateLimiters([]RateLimit{
+		{Service: "ec2", RequestsPerSecond: 20},
+		{Service: "ec2", Operation: "DescribeInstances", RequestsPerSecond: 5},
+		{Service: "iam", Operation: "GetRole", RequestsPerSecond: 10},
+	})
+
+	testCases := []struct {
+		Name              string
+		Service           string
+		Operation         string
+		ExpectedLimited   bool
+		ExpectedRate      float64
+		ExpectedNoLimiter bool
+	}{
+		{
+			Name:            "service",
+			Service:         "ec2",
+			Operation:       "DescribeVpcs",
+			ExpectedLimited: true,
+			ExpectedRate:    20,
+		},
+		{
+			Name:            "operation",
+			Service:         "ec2",
+			Operation:       "DescribeInstances",
+			ExpectedLimited: true,
+			ExpectedRate:    5,
+		},
+		{
+			Name:            "operation only",
+			Service:         "iam",
+			Operation:       "GetRole",
+			ExpectedLimited: true,
+			ExpectedRate:    10,
+		},
+		{
+			Name:              "other operation",
+			Service:           "iam",
+			Operation:         "GetPolicy",
+			ExpectedLimited:   true,
+			ExpectedNoLimiter: true,
+		},
+		{
+			Name:              "not configured",
+			Service:           "route53",
+			Operation:         "ListHostedZones",
+			ExpectedNoLimiter: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := limiters.limited(testCase.Service), testCase.ExpectedLimited; got != want {
+				t.Errorf("limited = %t, want %t", got, want)
+			}
+
+			l := limiters.get(testCase.Service, testCase.Operation)
+
+			if testCase.ExpectedNoLimiter {
+				if l != nil {
+					t.Errorf("expected no rate limiter, got rate %f", l.maxRate)
+				}
+
+				return
+			}
+
+			if l == nil {
+				t.Fatal("expected rate limiter, got none")
+			}
+
+			if got, want := l.maxRate, testCase.ExpectedRate; got != want {
+				t.Errorf("rate = %f, want %f", got, want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	l := newRateLimiter(10, 2)
+
+	// The burst is available immediately.
+	for i := 0; i < 2; i++ {
+		if wait, _, _ := l.reserve(now); wait != 0 {
+			t.Errorf("request %d: wait = %s, want 0", i, wait)
+		}
+	}
+
+	// Subsequent requests wait for a token.
+	if wait, _, _ := l.reserve(now); wait != 100*time.Millisecond {
+		t.Errorf("wait = %s, want 100ms", wait)
+	}
+	if wait, _, total := l.reserve(now); wait != 200*time.Millisecond || total != 300*time.Millisecond {
+		t.Errorf("wait = %s, total = %s, want 200ms, 300ms", wait, total)
+	}
+
+	// The bucket refills at the configured rate.
+	now = now.Add(time.Second)
+	if wait, _, _ := l.reserve(now); wait != 0 {
+		t.Errorf("wait = %s, want 0", wait)
+	}
+}
+
+func TestRateLimiterThrottled(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	l := newRateLimiter(10, 0)
+
+	if got, want := l.burst, 10.0; got != want {
+		t.Errorf("burst = %f, want %f", got, want)
+	}
+
+	if reduced, rate := l.throttled(now); !reduced || rate != 5 {
+		t.Errorf("throttled = %t, %f, want true, 5", reduced, rate)
+	}
+
+	// Concurrent throttled requests reduce the rate once.
+	if reduced, rate := l.throttled(now.Add(rateLimitDecreaseInterval / 2)); reduced || rate >= 10 {
+		t.Errorf("throttled = %t, %f, want false, < 10", reduced, rate)
+	}
+
+	now = now.Add(rateLimitDecreaseInterval)
+	for i := 0; i < 10; i++ {
+		l.throttled(now)
+		now = now.Add(rateLimitDecreaseInterval)
+	}
+
+	// The rate is not reduced below the minimum.
+	l.mu.Lock()
+	rate := l.rate(now)
+	l.mu.Unlock()
+
+	if min := 10 * rateLimitMinimumFraction; rate < min || rate > min+1 {
+		t.Errorf("rate = %f, want approximately %f", rate, min)
+	}
+
+	// The rate recovers to the configured rate.
+	now = now.Add(rateLimitRecoveryPeriod)
+
+	l.mu.Lock()
+	rate = l.rate(now)
+	l.mu.Unlock()
+
+	if rate != 10 {
+		t.Errorf("rate = %f, want 10", rate)
+	}
+}
@@ -0,0 +1,78 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// regionalClients lazily creates and caches the provider Meta for Regions other than the provider's.
+// The Meta for each Region is configured from the same provider configuration, including credentials.
+type regionalClients struct {
+	mu      sync.Mutex
+	config  Config
+	clients map[string]*AWSClient
+}
+
+func newRegionalClients(c *Config) *regionalClients {
+	return &regionalClients{
+		config:  *c,
+		clients: make(map[string]*AWSClient),
+	}
+}
+
+func (rc *regionalClients) get(ctx context.Context, client *AWSClient, region string) (*AWSClient, diag.Diagnostics) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if v, ok := rc.clients[region]; ok {
+		return v, nil
+	}
+
+	c := rc.config
+	c.Region = region
+
+	regional := &AWSClient{
+		ServicePackages: client.ServicePackages,
+	}
+	regional.SetHTTPClient(client.HTTPClient())
+
+	regional, diags := c.ConfigureProvider(ctx, regional)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Share the cache so that the Meta for a Region can be retrieved from the Meta for any other Region.
+	regional.regionalClients = rc
+	regional.APICallJournalWriter = client.APICallJournalWriter
+	rc.clients[region] = regional
+
+	return regional, diags
+}
+
+// ForRegion returns the provider Meta for the specified Region.
+// The Meta is created from the provider configuration the first time that it is requested.
+func (client *AWSClient) ForRegion(ctx context.Context, region string) (*AWSClient, diag.Diagnostics) {
+	if region == "" || region == client.Region || client.regionalClients == nil {
+		return client, nil
+	}
+
+	return client.regionalClients.get(ctx, client, region)
+}
+
+type regionalClientKeyType int
+
+var regionalClientKey regionalClientKeyType
+
+// NewRegionalClientContext returns a Context enhanced with the provider Meta for a resource's Region.
+func NewRegionalClientContext(ctx context.Context, client *AWSClient) context.Context {
+	return context.WithValue(ctx, regionalClientKey, client)
+}
+
+// RegionalClientFromContext returns the provider Meta for a resource's Region kept in Context, if any.
+func RegionalClientFromContext(ctx context.Context) (*AWSClient, bool) {
+	v, ok := ctx.Value(regionalClientKey).(*AWSClient)
+	return v, ok
+}
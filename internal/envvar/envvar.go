@@ -81,6 +81,28 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to limit what resource sweepers delete
+const (
+	// If true, sweepers list resources but do not delete them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Resources created less than this duration ago, e.g. "2h", are not deleted
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Comma-separated tag keys or key=value pairs. Resources with any of these tags are not deleted
+	SweepProtectedTags = "TF_AWS_SWEEP_PROTECTED_TAGS"
+
+	// Path of a file to which a report of swept resources is written.
+	// The report is CSV if the file name ends in .csv, otherwise JSON with one object per line
+	SweepReport = "TF_AWS_SWEEP_REPORT"
+)
+
+// Custom environment variables used by the provider at runtime
+const (
+	// Path of a file to which the AWS API calls made by each resource operation are appended as JSON lines
+	APICallJournal = "TF_AWS_API_CALL_JOURNAL"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -53,13 +53,13 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 
 // ExpandTags returns the API tags for the specified "tags" value.
 func (r *ResourceWithConfigure) ExpandTags(ctx context.Context, tags types.Map) tftags.KeyValueTags {
-	return r.Meta().DefaultTagsConfig.MergeTags(tftags.New(ctx, tags))
+	return r.Meta().DefaultTagsConfigForContext(ctx).MergeTags(tftags.New(ctx, tags))
 }
 
 // FlattenTags returns the "tags" value from the specified API tags.
 func (r *ResourceWithConfigure) FlattenTags(ctx context.Context, apiTags tftags.KeyValueTags) types.Map {
 	// AWS APIs often return empty lists of tags when none have been configured.
-	if v := apiTags.IgnoreAWS().IgnoreConfig(r.Meta().IgnoreTagsConfig).RemoveDefaultConfig(r.Meta().DefaultTagsConfig).Map(); len(v) == 0 {
+	if v := apiTags.IgnoreAWS().IgnoreConfig(r.Meta().IgnoreTagsConfig).RemoveDefaultConfig(r.Meta().DefaultTagsConfigForContext(ctx)).Map(); len(v) == 0 {
 		return tftags.Null
 	} else {
 		return flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
@@ -78,7 +78,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := r.Meta().DefaultTagsConfigForContext(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
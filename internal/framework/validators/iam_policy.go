@@ -0,0 +1,58 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyJSONValidator validates that a string Attribute's value is a valid IAM policy document.
+type iamPolicyJSONValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator iamPolicyJSONValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON IAM policy document"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator iamPolicyJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator iamPolicyJSONValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, errs := verify.ValidIAMPolicyJSON(request.ConfigValue.ValueString(), request.Path.String())
+
+	for _, err := range errs {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			err.Error(),
+		)
+	}
+}
+
+// IAMPolicyJSON returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid JSON IAM policy document.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+//
+// Attributes validated with IAMPolicyJSON are policy document attributes and
+// have the provider's policy lint findings reported as warnings.
+func IAMPolicyJSON() validator.String {
+	return iamPolicyJSONValidator{}
+}
+
+// IsIAMPolicyJSON returns whether the specified validator is an IAMPolicyJSON validator.
+func IsIAMPolicyJSON(v validator.String) bool {
+	_, ok := v.(iamPolicyJSONValidator)
+
+	return ok
+}
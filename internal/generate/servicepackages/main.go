@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -136,6 +137,10 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	TagsCreateOnly          bool
+	TagsCreateOperation     string
+	TagsCreateField         string
+	TagsForceNew            bool
 }
 
 type ServiceDatum struct {
@@ -237,6 +242,34 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+
+			if attr, ok := args.Keyword["createOnly"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid createOnly value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.TagsCreateOnly = b
+				}
+			}
+
+			if attr, ok := args.Keyword["createOperation"]; ok {
+				d.TagsCreateOperation = attr
+			}
+
+			if attr, ok := args.Keyword["createTagsField"]; ok {
+				d.TagsCreateField = attr
+			}
+
+			if attr, ok := args.Keyword["forceNewOnTagChange"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid forceNewOnTagChange value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.TagsForceNew = b
+				}
+			}
+
+			if (d.TagsCreateOperation == "") != (d.TagsCreateField == "") {
+				v.err = multierror.Append(v.err, fmt.Errorf("createOperation and createTagsField must be specified together: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
 		}
 	}
 
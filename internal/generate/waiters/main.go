@@ -0,0 +1,355 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+var (
+	name           = flag.String("Name", "", "name of the resource, e.g. Application")
+	finder         = flag.String("Finder", "", "name of the finder function, e.g. FindApplicationByName")
+	status         = flag.String("Status", "Status", "name of the status field, e.g. Status or State.Name")
+	statusReason   = flag.String("StatusReason", "", "optional name of the status reason field, e.g. StatusReason")
+	createdPending = flag.String("CreatedPending", "", "comma-separated pending statuses while creating")
+	createdTarget  = flag.String("CreatedTarget", "", "comma-separated target statuses once created")
+	updatedPending = flag.String("UpdatedPending", "", "comma-separated pending statuses while updating")
+	updatedTarget  = flag.String("UpdatedTarget", "", "comma-separated target statuses once updated")
+	deletedPending = flag.String("DeletedPending", "", "comma-separated pending statuses while deleting")
+	export         = flag.Bool("Export", false, "whether to export the generated functions")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-waiter-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type States struct {
+	Pending string
+	Target  string
+}
+
+type TemplateData struct {
+	Parameters  string
+	PackageName string
+	StdImports  []string
+	Imports     []string
+
+	Name       string
+	StatusFunc string
+	WaiterFunc string
+	WaitPrefix string
+
+	Finder     string
+	Params     string
+	Args       string
+	ResultType string
+
+	StatusBody       string
+	StatusReasonBody string
+
+	Created *States
+	Updated *States
+	Deleted *States
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *name == "" || *finder == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	filename := fmt.Sprintf("%s_waiter_gen.go", snakeCase(*name))
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	fn, file, err := findFunc(".", *finder)
+
+	if err != nil {
+		g.Fatalf("finding %s: %s", *finder, err)
+	}
+
+	td := TemplateData{
+		Parameters:  strings.Join(os.Args[1:], " "),
+		PackageName: servicePackage,
+		Name:        *name,
+		Finder:      *finder,
+		StatusFunc:  "status" + *name,
+		WaiterFunc:  lowerFirst(*name) + "Waiter",
+		WaitPrefix:  "wait" + *name,
+	}
+
+	if *export {
+		td.StatusFunc = "Status" + *name
+		td.WaitPrefix = "Wait" + *name
+	}
+
+	// The finder's signature must be func(ctx context.Context, <params>) (*<result>, error).
+	if fn.Type.Results == nil || len(fn.Type.Results.List) != 2 {
+		g.Fatalf("%s must return (*T, error)", *finder)
+	}
+
+	star, ok := fn.Type.Results.List[0].Type.(*ast.StarExpr)
+
+	if !ok {
+		g.Fatalf("%s must return (*T, error)", *finder)
+	}
+
+	td.ResultType = exprString(star.X)
+
+	var params, args []string
+	usedPackages := map[string]struct{}{}
+	addUsedPackages(star.X, usedPackages)
+
+	for i, field := range fn.Type.Params.List {
+		for j, ident := range field.Names {
+			if i == 0 && j == 0 {
+				// ctx context.Context.
+				continue
+			}
+
+			params = append(params, fmt.Sprintf("%s %s", ident.Name, exprString(field.Type)))
+			args = append(args, ident.Name)
+		}
+
+		addUsedPackages(field.Type, usedPackages)
+	}
+
+	td.Params = strings.Join(params, ", ")
+	td.Args = strings.Join(args, ", ")
+
+	if td.Created, err = states(*createdPending, *createdTarget, usedPackages); err != nil {
+		g.Fatalf("created statuses: %s", err)
+	}
+	if td.Updated, err = states(*updatedPending, *updatedTarget, usedPackages); err != nil {
+		g.Fatalf("updated statuses: %s", err)
+	}
+	if td.Deleted, err = states(*deletedPending, "", usedPackages); err != nil {
+		g.Fatalf("deleted statuses: %s", err)
+	}
+
+	imports := map[string]string{
+		"context": "",
+		"time":    "",
+		"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry":       "",
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource": "",
+	}
+
+	sdkv2 := false
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		importName := path.Base(importPath)
+		if spec.Name != nil {
+			importName = spec.Name.Name
+		}
+
+		if _, ok := usedPackages[importName]; !ok {
+			continue
+		}
+
+		if spec.Name != nil {
+			imports[importPath] = spec.Name.Name
+		} else {
+			imports[importPath] = ""
+		}
+
+		if strings.HasPrefix(importPath, "github.com/aws/aws-sdk-go-v2/") {
+			sdkv2 = true
+		}
+	}
+
+	if !sdkv2 {
+		imports["github.com/aws/aws-sdk-go/aws"] = ""
+	}
+
+	td.StatusBody = fieldBody(*status, sdkv2)
+	if *statusReason != "" {
+		td.StatusReasonBody = fieldBody(*statusReason, sdkv2)
+	}
+
+	for importPath, importName := range imports {
+		spec := strconv.Quote(importPath)
+		if importName != "" {
+			spec = fmt.Sprintf("%s %s", importName, spec)
+		}
+
+		if strings.Contains(importPath, ".") {
+			td.Imports = append(td.Imports, spec)
+		} else {
+			td.StdImports = append(td.StdImports, spec)
+		}
+	}
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("waiter", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+// findFunc finds the named function declaration in the Go source files in the specified directory.
+func findFunc(dir, funcName string) (*ast.FuncDecl, *ast.File, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == funcName {
+					return fn, file, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil, fmt.Errorf("function not found")
+}
+
+// addUsedPackages adds the names of packages referenced in the expression.
+func addUsedPackages(expr ast.Node, packages map[string]struct{}) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				packages[ident.Name] = struct{}{}
+			}
+		}
+
+		return true
+	})
+}
+
+// states returns the Go expressions for comma-separated statuses.
+// Statuses may be string literals, e.g. ACTIVE, or constants, e.g. ec2.StateAvailable.
+func states(pending, target string, usedPackages map[string]struct{}) (*States, error) {
+	if pending == "" && target == "" {
+		return nil, nil
+	}
+
+	p, err := statusList(pending, usedPackages)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := statusList(target, usedPackages)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &States{Pending: p, Target: t}, nil
+}
+
+func statusList(s string, usedPackages map[string]struct{}) (string, error) {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		if pkg, _, ok := strings.Cut(v, "."); ok {
+			expr, err := parser.ParseExpr(v)
+			if err != nil {
+				return "", err
+			}
+
+			usedPackages[pkg] = struct{}{}
+			values = append(values, fmt.Sprintf("string(%s)", exprString(expr)))
+		} else {
+			values = append(values, strconv.Quote(v))
+		}
+	}
+
+	return strings.Join(values, ", "), nil
+}
+
+// fieldBody returns the body of a function returning the string value of the specified field of v.
+// Intermediate fields of a nested field are checked for nil.
+func fieldBody(field string, sdkv2 bool) string {
+	var b strings.Builder
+
+	parts := strings.Split(field, ".")
+	for i := range parts[:len(parts)-1] {
+		fmt.Fprintf(&b, "if v.%s == nil {\nreturn \"\"\n}\n", strings.Join(parts[:i+1], "."))
+	}
+
+	if sdkv2 {
+		fmt.Fprintf(&b, "return string(v.%s)", field)
+	} else {
+		fmt.Fprintf(&b, "return aws.StringValue(v.%s)", field)
+	}
+
+	return b.String()
+}
+
+func exprString(expr ast.Expr) string {
+	var b bytes.Buffer
+
+	if err := printer.Fprint(&b, token.NewFileSet(), expr); err != nil {
+		log.Fatalf("printing expression: %s", err)
+	}
+
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[0:1]) + s[1:]
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package interceptors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const AuditLogName = "audit_log"
+
+// auditLog writes one structured record per resource CRUD call.
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// auditRecord is a single audit log record.
+type auditRecord struct {
+	Time       time.Time `json:"time"`
+	TypeName   string    `json:"type"`
+	Operation  Operation `json:"operation"`
+	ID         string    `json:"id,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	Outcome    string    `json:"outcome"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// newAuditLog creates an audit log interceptor.
+// If the "path" option is set, records are also appended as JSON lines to that file.
+func newAuditLog(options map[string]string) (Interceptor, error) {
+	if err := checkOptions(options, "path"); err != nil {
+		return nil, err
+	}
+
+	return &auditLog{path: options["path"]}, nil
+}
+
+func (a *auditLog) Before(ctx context.Context, invocation *Invocation) error {
+	return nil
+}
+
+func (a *auditLog) After(ctx context.Context, invocation *Invocation, err error) {
+	record := auditRecord{
+		Time:       invocation.Start.UTC(),
+		TypeName:   invocation.TypeName,
+		Operation:  invocation.Operation,
+		ID:         invocation.ID,
+		DurationMS: invocation.Duration().Milliseconds(),
+		Outcome:    "success",
+	}
+
+	if err != nil {
+		record.Outcome = "error"
+		record.Error = err.Error()
+	}
+
+	fields := map[string]any{
+		"tf_aws.audit.type":        record.TypeName,
+		"tf_aws.audit.operation":   string(record.Operation),
+		"tf_aws.audit.id":          record.ID,
+		"tf_aws.audit.duration_ms": record.DurationMS,
+		"tf_aws.audit.outcome":     record.Outcome,
+	}
+	if record.Error != "" {
+		fields["tf_aws.audit.error"] = record.Error
+	}
+	tflog.Info(ctx, "resource operation", fields)
+
+	if a.path == "" {
+		return
+	}
+
+	if err := a.write(record); err != nil {
+		tflog.Warn(ctx, "writing audit log record", map[string]any{
+			"path":  a.path,
+			"error": err.Error(),
+		})
+	}
+}
+
+func (a *auditLog) write(record auditRecord) error {
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(f, string(b)); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,94 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const DenyResourceTypesName = "deny_resource_types"
+
+// denyResourceTypes prevents CRUD operations on a list of resource types.
+type denyResourceTypes struct {
+	typeNames  map[string]struct{}
+	operations map[Operation]struct{}
+}
+
+// newDenyResourceTypes creates a deny list interceptor.
+// The "resource_types" option is a comma-separated list of denied resource types.
+// The optional "operations" option is a comma-separated list of denied operations and defaults to "create,update,delete".
+func newDenyResourceTypes(options map[string]string) (Interceptor, error) {
+	if err := checkOptions(options, "operations", "resource_types"); err != nil {
+		return nil, err
+	}
+
+	typeNames := splitOption(options["resource_types"])
+
+	if len(typeNames) == 0 {
+		return nil, errors.New(`"resource_types" option is required`)
+	}
+
+	d := &denyResourceTypes{
+		typeNames:  make(map[string]struct{}),
+		operations: make(map[Operation]struct{}),
+	}
+
+	for _, v := range typeNames {
+		d.typeNames[v] = struct{}{}
+	}
+
+	operations := splitOption(options["operations"])
+
+	if len(operations) == 0 {
+		operations = []string{"create", "update", "delete"}
+	}
+
+	for _, v := range operations {
+		var operation Operation
+
+		switch strings.ToLower(v) {
+		case "create":
+			operation = OperationCreate
+		case "read":
+			operation = OperationRead
+		case "update":
+			operation = OperationUpdate
+		case "delete":
+			operation = OperationDelete
+		default:
+			return nil, fmt.Errorf("unsupported operation: %s", v)
+		}
+
+		d.operations[operation] = struct{}{}
+	}
+
+	return d, nil
+}
+
+func (d *denyResourceTypes) Before(ctx context.Context, invocation *Invocation) error {
+	if _, ok := d.typeNames[invocation.TypeName]; !ok {
+		return nil
+	}
+
+	if _, ok := d.operations[invocation.Operation]; !ok {
+		return nil
+	}
+
+	return fmt.Errorf("%s of %s is denied by provider configuration", invocation.Operation, invocation.TypeName)
+}
+
+func (d *denyResourceTypes) After(ctx context.Context, invocation *Invocation, err error) {}
+
+// splitOption splits a comma-separated option value.
+func splitOption(s string) []string {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,162 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Operation is a resource CRUD operation.
+type Operation string
+
+const (
+	OperationCreate Operation = "Create"
+	OperationRead   Operation = "Read"
+	OperationUpdate Operation = "Update"
+	OperationDelete Operation = "Delete"
+)
+
+// Invocation describes a single call to a resource's CRUD handler.
+type Invocation struct {
+	ServicePackageName string    // Canonical service package name defined as a constant in names package
+	ResourceName       string    // Friendly resource name, e.g. "Subnet"
+	TypeName           string    // Terraform resource type name, e.g. "aws_subnet"
+	Operation          Operation // CRUD operation
+	ID                 string    // Resource ID, if known
+	Start              time.Time // Time that the invocation started
+}
+
+// Duration returns the time elapsed since the invocation started.
+func (i *Invocation) Duration() time.Duration {
+	return time.Since(i.Start)
+}
+
+// An Interceptor is functionality configured in the provider that is invoked around every resource CRUD handler.
+// Interceptors have the same semantics for Plugin SDK v2 and Plugin Framework resources.
+type Interceptor interface {
+	// Before is invoked before the resource's CRUD handler.
+	// Returning an error prevents the handler, and any further interceptors, from running.
+	Before(context.Context, *Invocation) error
+	// After is invoked after the resource's CRUD handler with any error returned by the handler.
+	// If Before has run, After is also invoked when the handler is prevented from running, with the reason.
+	After(context.Context, *Invocation, error)
+}
+
+// Chain is an ordered list of interceptors.
+type Chain []Interceptor
+
+// Before runs the Before method of each interceptor in the chain, first to last, stopping at the first error.
+// After is run for those interceptors that have already been invoked.
+func (c Chain) Before(ctx context.Context, invocation *Invocation) error {
+	for i, v := range c {
+		if err := v.Before(ctx, invocation); err != nil {
+			c[:i].After(ctx, invocation, err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// After runs the After method of each interceptor in the chain, last to first.
+func (c Chain) After(ctx context.Context, invocation *Invocation, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].After(ctx, invocation, err)
+	}
+}
+
+// Factory creates an interceptor from the options specified in provider configuration.
+type Factory func(options map[string]string) (Interceptor, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Factory{
+		AuditLogName:          newAuditLog,
+		DenyResourceTypesName: newDenyResourceTypes,
+		OperationTimingName:   newOperationTiming,
+	}
+)
+
+// Register registers an interceptor factory under the specified name.
+// It panics if the name is already registered.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("duplicate interceptor: %s", name))
+	}
+
+	registry[name] = factory
+}
+
+// Names returns the sorted names of all registered interceptors.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for k := range registry {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// New creates the named interceptor.
+func New(name string, options map[string]string) (Interceptor, error) {
+	registryMu.RLock()
+	factory, ok := registry[name]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown interceptor: %s", name)
+	}
+
+	v, err := factory(options)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating interceptor (%s): %w", name, err)
+	}
+
+	return v, nil
+}
+
+// checkOptions returns an error if any option is not in the allowed set.
+func checkOptions(options map[string]string, allowed ...string) error {
+	for k := range options {
+		found := false
+
+		for _, v := range allowed {
+			if k == v {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("unsupported option: %s", k)
+		}
+	}
+
+	return nil
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewContext returns a Context enhanced with the specified invocation.
+func NewContext(ctx context.Context, invocation *Invocation) context.Context {
+	return context.WithValue(ctx, contextKey, invocation)
+}
+
+// FromContext returns the invocation kept in Context, if any.
+func FromContext(ctx context.Context) (*Invocation, bool) {
+	v, ok := ctx.Value(contextKey).(*Invocation)
+	return v, ok
+}
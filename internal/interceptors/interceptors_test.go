@@ -0,0 +1,290 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type recordingInterceptor struct {
+	name      string
+	beforeErr error
+	calls     *[]string
+}
+
+func (r recordingInterceptor) Before(ctx context.Context, invocation *Invocation) error {
+	*r.calls = append(*r.calls, "before:"+r.name)
+	return r.beforeErr
+}
+
+func (r recordingInterceptor) After(ctx context.Context, invocation *Invocation, err error) {
+	s := "after:" + r.name
+	if err != nil {
+		s += ":error"
+	}
+	*r.calls = append(*r.calls, s)
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		BeforeErr   error
+		ExpectError bool
+		Expected    []string
+	}{
+		{
+			Name:     "success",
+			Expected: []string{"before:a", "before:b", "before:c", "after:c", "after:b", "after:a"},
+		},
+		{
+			Name:        "before error",
+			BeforeErr:   errors.New("denied"),
+			ExpectError: true,
+			Expected:    []string{"before:a", "before:b", "after:a:error"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var calls []string
+			chain := Chain{
+				recordingInterceptor{name: "a", calls: &calls},
+				recordingInterceptor{name: "b", calls: &calls, beforeErr: testCase.BeforeErr},
+				recordingInterceptor{name: "c", calls: &calls},
+			}
+			invocation := &Invocation{TypeName: "aws_test", Operation: OperationCreate, Start: time.Now()}
+
+			err := chain.Before(ctx, invocation)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				chain.After(ctx, invocation, nil)
+			}
+
+			if diff := cmp.Diff(calls, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Options     map[string]string
+		ExpectError bool
+	}{
+		{
+			Name: AuditLogName,
+		},
+		{
+			Name:    AuditLogName,
+			Options: map[string]string{"path": "audit.log"},
+		},
+		{
+			Name:        AuditLogName,
+			Options:     map[string]string{"level": "info"},
+			ExpectError: true,
+		},
+		{
+			Name:        DenyResourceTypesName,
+			ExpectError: true,
+		},
+		{
+			Name:    DenyResourceTypesName,
+			Options: map[string]string{"resource_types": "aws_instance"},
+		},
+		{
+			Name:        DenyResourceTypesName,
+			Options:     map[string]string{"resource_types": "aws_instance", "operations": "import"},
+			ExpectError: true,
+		},
+		{
+			Name: OperationTimingName,
+		},
+		{
+			Name:        "unknown",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(testCase.Name, testCase.Options)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("New(%q, %v) error = %v, want error %t", testCase.Name, testCase.Options, err, want)
+			}
+		})
+	}
+}
+
+func TestDenyResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Options     map[string]string
+		TypeName    string
+		Operation   Operation
+		ExpectError bool
+	}{
+		{
+			Name:        "denied create",
+			Options:     map[string]string{"resource_types": "aws_instance, aws_s3_bucket"},
+			TypeName:    "aws_s3_bucket",
+			Operation:   OperationCreate,
+			ExpectError: true,
+		},
+		{
+			Name:      "default read allowed",
+			Options:   map[string]string{"resource_types": "aws_instance, aws_s3_bucket"},
+			TypeName:  "aws_s3_bucket",
+			Operation: OperationRead,
+		},
+		{
+			Name:      "other type",
+			Options:   map[string]string{"resource_types": "aws_instance"},
+			TypeName:  "aws_s3_bucket",
+			Operation: OperationDelete,
+		},
+		{
+			Name:        "denied read",
+			Options:     map[string]string{"resource_types": "aws_instance", "operations": "Read"},
+			TypeName:    "aws_instance",
+			Operation:   OperationRead,
+			ExpectError: true,
+		},
+		{
+			Name:      "only read denied",
+			Options:   map[string]string{"resource_types": "aws_instance", "operations": "read"},
+			TypeName:  "aws_instance",
+			Operation: OperationUpdate,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := newDenyResourceTypes(testCase.Options)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = v.Before(context.Background(), &Invocation{TypeName: testCase.TypeName, Operation: testCase.Operation})
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("Before() error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+
+	h := newHistogram([]time.Duration{time.Second, time.Minute})
+
+	for _, v := range []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, time.Hour} {
+		h.observe(v)
+	}
+
+	if got, want := h.Counts, []int64{2, 1, 1}; !cmp.Equal(got, want) {
+		t.Errorf("Counts = %v, want %v", got, want)
+	}
+
+	if got, want := h.Count(), int64(4); got != want {
+		t.Errorf("Count() = %d, want %d", got, want)
+	}
+}
+
+func TestOperationTiming(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	v, err := newOperationTiming(nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	timing := v.(*operationTiming)
+	invocation := &Invocation{TypeName: "aws_test", Operation: OperationCreate, Start: time.Now()}
+	timing.After(ctx, invocation, nil)
+	timing.After(ctx, invocation, errors.New("failed"))
+
+	h, ok := timing.Histogram("aws_test", OperationCreate)
+
+	if !ok {
+		t.Fatal("expected histogram")
+	}
+
+	if got, want := h.Count(), int64(2); got != want {
+		t.Errorf("Count() = %d, want %d", got, want)
+	}
+
+	if _, ok := timing.Histogram("aws_test", OperationRead); ok {
+		t.Error("unexpected histogram")
+	}
+}
+
+func TestAuditLogPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	v, err := newAuditLog(map[string]string{"path": path})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v.After(ctx, &Invocation{TypeName: "aws_test", Operation: OperationCreate, ID: "test-1", Start: time.Now()}, nil)
+	v.After(ctx, &Invocation{TypeName: "aws_test", Operation: OperationDelete, ID: "test-1", Start: time.Now()}, errors.New("failed"))
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d records, want %d", got, want)
+	}
+
+	if !strings.Contains(lines[0], `"outcome":"success"`) || !strings.Contains(lines[1], `"error":"failed"`) {
+		t.Errorf("unexpected records: %s", lines)
+	}
+}
@@ -0,0 +1,139 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const OperationTimingName = "operation_timing"
+
+// operationTimingBuckets are the histogram bucket upper bounds.
+var operationTimingBuckets = []time.Duration{
+	1 * time.Second,
+	5 * time.Second,
+	30 * time.Second,
+	1 * time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+}
+
+// Histogram counts operation durations in buckets.
+// Counts[i] is the number of durations no greater than Buckets[i], the final count is for durations greater than all buckets.
+type Histogram struct {
+	Buckets []time.Duration
+	Counts  []int64
+	Total   time.Duration
+}
+
+func newHistogram(buckets []time.Duration) *Histogram {
+	return &Histogram{
+		Buckets: buckets,
+		Counts:  make([]int64, len(buckets)+1),
+	}
+}
+
+func (h *Histogram) observe(d time.Duration) {
+	i := 0
+	for ; i < len(h.Buckets); i++ {
+		if d <= h.Buckets[i] {
+			break
+		}
+	}
+
+	h.Counts[i]++
+	h.Total += d
+}
+
+// Count returns the total number of observations.
+func (h *Histogram) Count() int64 {
+	var n int64
+
+	for _, v := range h.Counts {
+		n += v
+	}
+
+	return n
+}
+
+func (h *Histogram) fields() map[string]any {
+	fields := make(map[string]any, len(h.Counts))
+
+	for i, v := range h.Counts {
+		if i < len(h.Buckets) {
+			fields[fmt.Sprintf("le_%s", h.Buckets[i])] = v
+		} else {
+			fields["le_inf"] = v
+		}
+	}
+
+	return fields
+}
+
+// operationTiming keeps a histogram of operation durations per resource type and operation.
+type operationTiming struct {
+	mu         sync.Mutex
+	histograms map[string]*Histogram
+}
+
+// newOperationTiming creates an operation timing interceptor.
+func newOperationTiming(options map[string]string) (Interceptor, error) {
+	if err := checkOptions(options); err != nil {
+		return nil, err
+	}
+
+	return &operationTiming{
+		histograms: make(map[string]*Histogram),
+	}, nil
+}
+
+func (t *operationTiming) Before(ctx context.Context, invocation *Invocation) error {
+	return nil
+}
+
+func (t *operationTiming) After(ctx context.Context, invocation *Invocation, err error) {
+	d := invocation.Duration()
+	key := operationTimingKey(invocation.TypeName, invocation.Operation)
+
+	t.mu.Lock()
+	h, ok := t.histograms[key]
+	if !ok {
+		h = newHistogram(operationTimingBuckets)
+		t.histograms[key] = h
+	}
+	h.observe(d)
+	fields := h.fields()
+	count, total := h.Count(), h.Total
+	t.mu.Unlock()
+
+	fields["tf_aws.timing.type"] = invocation.TypeName
+	fields["tf_aws.timing.operation"] = string(invocation.Operation)
+	fields["tf_aws.timing.duration_ms"] = d.Milliseconds()
+	fields["tf_aws.timing.count"] = count
+	fields["tf_aws.timing.total_ms"] = total.Milliseconds()
+	tflog.Debug(ctx, "resource operation timing", fields)
+}
+
+// Histogram returns a copy of the histogram for the specified resource type and operation.
+func (t *operationTiming) Histogram(typeName string, operation Operation) (Histogram, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, ok := t.histograms[operationTimingKey(typeName, operation)]
+	if !ok {
+		return Histogram{}, false
+	}
+
+	return Histogram{
+		Buckets: h.Buckets,
+		Counts:  append([]int64(nil), h.Counts...),
+		Total:   h.Total,
+	}, true
+}
+
+func operationTimingKey(typeName string, operation Operation) string {
+	return typeName + "." + string(operation)
+}
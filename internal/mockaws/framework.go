@@ -0,0 +1,154 @@
+package mockaws
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FrameworkResource drives a Terraform Plugin Framework resource's CRUD handlers.
+// Plans are set from the resource's model, e.g. a resourceXxxData struct; computed
+// attributes that are unknown during plan should be set to Unknown values in the model.
+type FrameworkResource struct {
+	Resource resource.Resource
+	Meta     any
+}
+
+// Create creates the resource with the specified planned model.
+func (r *FrameworkResource) Create(ctx context.Context, plan any) (tfsdk.State, diag.Diagnostics) {
+	diags := r.configure(ctx)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+
+	p, d := r.plan(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+
+	request := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: p.Schema, Raw: p.Raw},
+		Plan:   p,
+	}
+	response := resource.CreateResponse{
+		State: r.nullState(ctx, p),
+	}
+
+	r.Resource.Create(ctx, request, &response)
+	diags.Append(response.Diagnostics...)
+
+	return response.State, diags
+}
+
+// Read refreshes the resource's state.
+// A null state is returned if the resource no longer exists.
+func (r *FrameworkResource) Read(ctx context.Context, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	diags := r.configure(ctx)
+	if diags.HasError() {
+		return state, diags
+	}
+
+	request := resource.ReadRequest{
+		State: state,
+	}
+	response := resource.ReadResponse{
+		State: state,
+	}
+
+	r.Resource.Read(ctx, request, &response)
+	diags.Append(response.Diagnostics...)
+
+	return response.State, diags
+}
+
+// Update updates the resource from its current state to the specified planned model.
+func (r *FrameworkResource) Update(ctx context.Context, state tfsdk.State, plan any) (tfsdk.State, diag.Diagnostics) {
+	diags := r.configure(ctx)
+	if diags.HasError() {
+		return state, diags
+	}
+
+	p, d := r.plan(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return state, diags
+	}
+
+	request := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: p.Schema, Raw: p.Raw},
+		Plan:   p,
+		State:  state,
+	}
+	response := resource.UpdateResponse{
+		State: state,
+	}
+
+	r.Resource.Update(ctx, request, &response)
+	diags.Append(response.Diagnostics...)
+
+	return response.State, diags
+}
+
+// Delete deletes the resource.
+func (r *FrameworkResource) Delete(ctx context.Context, state tfsdk.State) diag.Diagnostics {
+	diags := r.configure(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	request := resource.DeleteRequest{
+		State: state,
+	}
+	response := resource.DeleteResponse{
+		State: state,
+	}
+
+	r.Resource.Delete(ctx, request, &response)
+	diags.Append(response.Diagnostics...)
+
+	return diags
+}
+
+// configure passes the provider's meta to the resource.
+func (r *FrameworkResource) configure(ctx context.Context) diag.Diagnostics {
+	v, ok := r.Resource.(resource.ResourceWithConfigure)
+
+	if !ok {
+		return nil
+	}
+
+	var response resource.ConfigureResponse
+	v.Configure(ctx, resource.ConfigureRequest{ProviderData: r.Meta}, &response)
+
+	return response.Diagnostics
+}
+
+func (r *FrameworkResource) plan(ctx context.Context, model any) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var response resource.SchemaResponse
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, &response)
+	diags.Append(response.Diagnostics...)
+	if diags.HasError() {
+		return tfsdk.Plan{}, diags
+	}
+
+	plan := tfsdk.Plan{
+		Schema: response.Schema,
+		Raw:    tftypes.NewValue(response.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(plan.Set(ctx, model)...)
+
+	return plan, diags
+}
+
+func (r *FrameworkResource) nullState(ctx context.Context, plan tfsdk.Plan) tfsdk.State {
+	return tfsdk.State{
+		Schema: plan.Schema,
+		Raw:    tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil),
+	}
+}
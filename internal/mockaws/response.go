@@ -0,0 +1,103 @@
+package mockaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Response is a scripted response to an AWS API request.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+func (r Response) statusCode() int {
+	if r.StatusCode == 0 {
+		return http.StatusOK
+	}
+
+	return r.StatusCode
+}
+
+// JSON returns a successful AWS JSON or REST-JSON protocol response with the JSON encoding of v as body.
+func JSON(v any) Response {
+	body, err := json.Marshal(v)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return Response{
+		Header: http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		Body:   string(body),
+	}
+}
+
+// JSONError returns an AWS JSON or REST-JSON protocol error response.
+func JSONError(statusCode int, code, message string) Response {
+	body, err := json.Marshal(map[string]string{
+		"__type":  code,
+		"message": message,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	return Response{
+		StatusCode: statusCode,
+		Header: http.Header{
+			"Content-Type":     []string{"application/x-amz-json-1.1"},
+			"X-Amzn-Errortype": []string{code},
+		},
+		Body: string(body),
+	}
+}
+
+// XML returns an AWS protocol response with the specified XML body.
+func XML(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       body,
+	}
+}
+
+// Query returns a successful AWS query protocol response.
+// result is the XML content of the <ActionResult> element.
+func Query(action, result string) Response {
+	return XML(http.StatusOK, fmt.Sprintf(`<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>mock</RequestId></ResponseMetadata></%[1]sResponse>`, action, result))
+}
+
+// QueryError returns an AWS query protocol error response.
+func QueryError(statusCode int, code, message string) Response {
+	return XML(statusCode, fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>mock</RequestId></ErrorResponse>`, code, escapeXML(message)))
+}
+
+// EC2 returns a successful Amazon EC2 query protocol response.
+// result is the XML content of the <ActionResponse> element.
+func EC2(action, result string) Response {
+	return XML(http.StatusOK, fmt.Sprintf(`<%[1]sResponse><requestId>mock</requestId>%[2]s</%[1]sResponse>`, action, result))
+}
+
+// EC2Error returns an Amazon EC2 query protocol error response.
+func EC2Error(statusCode int, code, message string) Response {
+	return XML(statusCode, fmt.Sprintf(`<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>mock</RequestID></Response>`, code, escapeXML(message)))
+}
+
+// RESTXMLError returns an AWS REST-XML protocol error response.
+func RESTXMLError(statusCode int, code, message string) Response {
+	return XML(statusCode, fmt.Sprintf(`<Error><Code>%s</Code><Message>%s</Message><RequestId>mock</RequestId></Error>`, code, escapeXML(message)))
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+
+	xml.EscapeText(&b, []byte(s)) //nolint:errcheck // Writes to memory.
+
+	return b.String()
+}
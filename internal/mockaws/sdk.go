@@ -0,0 +1,60 @@
+package mockaws
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// SDKResource drives a Terraform Plugin SDK v2 resource's CRUD handlers the way
+// the plugin SDK does during plan, apply and refresh.
+type SDKResource struct {
+	Resource *schema.Resource
+	Meta     any
+}
+
+// Create plans and applies creation of the resource with the specified configuration.
+func (r *SDKResource) Create(ctx context.Context, config map[string]any) (*terraform.InstanceState, diag.Diagnostics) {
+	return r.apply(ctx, nil, config)
+}
+
+// Read refreshes the resource's state.
+// A nil state is returned if the resource no longer exists.
+func (r *SDKResource) Read(ctx context.Context, state *terraform.InstanceState) (*terraform.InstanceState, diag.Diagnostics) {
+	return r.Resource.RefreshWithoutUpgrade(ctx, state, r.Meta)
+}
+
+// Update plans and applies changes to the resource's configuration.
+// The resource is replaced if any changed attribute forces a new resource.
+func (r *SDKResource) Update(ctx context.Context, state *terraform.InstanceState, config map[string]any) (*terraform.InstanceState, diag.Diagnostics) {
+	return r.apply(ctx, state, config)
+}
+
+// Delete applies destruction of the resource.
+func (r *SDKResource) Delete(ctx context.Context, state *terraform.InstanceState) diag.Diagnostics {
+	_, diags := r.Resource.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, r.Meta)
+
+	return diags
+}
+
+func (r *SDKResource) apply(ctx context.Context, state *terraform.InstanceState, config map[string]any) (*terraform.InstanceState, diag.Diagnostics) {
+	c := terraform.NewResourceConfigRaw(config)
+
+	if diags := r.Resource.Validate(c); diags.HasError() {
+		return state, diags
+	}
+
+	diff, err := r.Resource.Diff(ctx, state, c, r.Meta)
+
+	if err != nil {
+		return state, diag.FromErr(err)
+	}
+
+	if diff == nil || diff.Empty() {
+		return state, nil
+	}
+
+	return r.Resource.Apply(ctx, state, diff, r.Meta)
+}
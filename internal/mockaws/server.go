@@ -0,0 +1,264 @@
+// Package mockaws provides an in-process stand-in for AWS APIs so that resource CRUD
+// handlers can be unit tested without an AWS account.
+//
+// A Server answers every AWS API request with scripted responses. Operations are identified
+// by name: the X-Amz-Target operation for the AWS JSON protocols, the Action parameter for
+// the AWS query protocols and "METHOD /path" for the REST protocols, where path may contain
+// path.Match patterns.
+package mockaws
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// AccountID is the AWS account ID of the configured AWSClient.
+	AccountID = "123456789012"
+	// Region is the AWS Region of the configured AWSClient.
+	Region = "us-west-2" //lintignore:AWSAT003
+)
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`)
+
+// Request is an AWS API request received by a Server.
+type Request struct {
+	// Service is the AWS API signing name, e.g. "ec2" or "logs".
+	Service   string
+	Operation string
+	Header    http.Header
+	URL       *url.URL
+	Body      []byte
+}
+
+// Form returns the parsed body of an AWS query protocol request.
+func (r *Request) Form() url.Values {
+	v, _ := url.ParseQuery(string(r.Body))
+
+	return v
+}
+
+// HandlerFunc returns the response to an AWS API request.
+type HandlerFunc func(*Request) Response
+
+type handler struct {
+	f         HandlerFunc
+	responses []Response
+}
+
+// next returns the next scripted response.
+// The caller must hold the Server's mutex.
+func (h *handler) next() Response {
+	// The last response is repeated, e.g. for waiters polling status.
+	response := h.responses[0]
+	if len(h.responses) > 1 {
+		h.responses = h.responses[1:]
+	}
+
+	return response
+}
+
+// Server is an in-process HTTP server standing in for AWS APIs.
+type Server struct {
+	t        testing.TB
+	server   *httptest.Server
+	mutex    sync.Mutex
+	handlers map[string]*handler
+	requests []*Request
+}
+
+// NewServer starts a new Server. The Server is closed when the test completes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		t:        t,
+		handlers: make(map[string]*handler),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// URL returns the Server's base URL.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Handle scripts the responses to an operation. Responses are returned in order,
+// with the last response repeated for any further requests.
+func (s *Server) Handle(operation string, responses ...Response) {
+	s.t.Helper()
+
+	if len(responses) == 0 {
+		s.t.Fatalf("no responses for operation %s", operation)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.handlers[operation] = &handler{responses: responses}
+}
+
+// HandleFunc registers a function that returns the responses to an operation.
+func (s *Server) HandleFunc(operation string, f HandlerFunc) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.handlers[operation] = &handler{f: f}
+}
+
+// Requests returns the requests received for an operation, in order.
+func (s *Server) Requests(operation string) []*Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var requests []*Request
+
+	for _, v := range s.requests {
+		if v.Operation == operation {
+			requests = append(requests, v)
+		}
+	}
+
+	return requests
+}
+
+// Config returns provider configuration with all service endpoints set to the Server.
+func (s *Server) Config() *conns.Config {
+	endpoints := make(map[string]string)
+
+	for _, v := range names.ProviderPackages() {
+		endpoints[v] = s.URL()
+	}
+
+	return &conns.Config{
+		AccessKey:                     "mock-access-key",
+		EC2MetadataServiceEnableState: imds.ClientDisabled,
+		Endpoints:                     endpoints,
+		MaxRetries:                    1,
+		Region:                        Region,
+		SecretKey:                     "mock-secret-key",
+		SkipCredsValidation:           true,
+		SkipRegionValidation:          true,
+		SkipRequestingAccountId:       true,
+		SuppressDebugLog:              true,
+	}
+}
+
+// Client returns an AWSClient configured from Config.
+func (s *Server) Client(ctx context.Context) *conns.AWSClient {
+	s.t.Helper()
+
+	return s.ClientWithConfig(ctx, s.Config())
+}
+
+// ClientWithConfig returns an AWSClient configured from the specified configuration,
+// usually obtained from Config and then modified.
+func (s *Server) ClientWithConfig(ctx context.Context, config *conns.Config) *conns.AWSClient {
+	s.t.Helper()
+
+	client, diags := config.ConfigureProvider(ctx, new(conns.AWSClient))
+
+	if diags.HasError() {
+		s.t.Fatalf("configuring provider: %v", diags)
+	}
+
+	client.AccountID = AccountID
+
+	return client
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	request := &Request{
+		Header: r.Header.Clone(),
+		URL:    r.URL,
+		Body:   body,
+	}
+
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); len(m) == 2 {
+		request.Service = m[1]
+	}
+
+	switch contentType := r.Header.Get("Content-Type"); {
+	case r.Header.Get("X-Amz-Target") != "":
+		target := r.Header.Get("X-Amz-Target")
+		request.Operation = target[strings.LastIndex(target, ".")+1:]
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		request.Operation = request.Form().Get("Action")
+	default:
+		request.Operation = fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+	}
+
+	s.mutex.Lock()
+	s.requests = append(s.requests, request)
+	requestID := len(s.requests)
+	h := s.handler(r.Method, request.Operation)
+	var response Response
+	if h != nil && h.f == nil {
+		response = h.next()
+	}
+	s.mutex.Unlock()
+
+	switch {
+	case h == nil:
+		s.t.Errorf("unhandled %s operation: %s", request.Service, request.Operation)
+		response = JSONError(http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("no response scripted for %s", request.Operation))
+	case h.f != nil:
+		response = h.f(request)
+	}
+
+	for k, v := range response.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("X-Amzn-Requestid", fmt.Sprintf("mock-request-%d", requestID))
+	w.WriteHeader(response.statusCode())
+	io.Copy(w, bytes.NewBufferString(response.Body)) //nolint:errcheck // Best effort.
+}
+
+// handler returns the handler for an operation.
+// The caller must hold the mutex.
+func (s *Server) handler(method, operation string) *handler {
+	if h, ok := s.handlers[operation]; ok {
+		return h
+	}
+
+	// REST operations may be registered with a path pattern.
+	if !strings.HasPrefix(operation, method+" ") {
+		return nil
+	}
+
+	requestPath := strings.TrimPrefix(operation, method+" ")
+
+	for k, h := range s.handlers {
+		if pattern := strings.TrimPrefix(k, method+" "); pattern != k {
+			if ok, _ := path.Match(pattern, requestPath); ok {
+				return h
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package mockaws_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/mockaws"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := mockaws.NewServer(t)
+	client := server.Client(ctx)
+
+	// AWS JSON protocol.
+	server.Handle("DescribeResourcePolicies",
+		mockaws.JSONError(http.StatusBadRequest, "ThrottlingException", "Rate exceeded"),
+		mockaws.JSON(map[string]any{
+			"resourcePolicies": []any{
+				map[string]any{"policyName": "test"},
+			},
+		}),
+	)
+
+	describeOutput, err := client.LogsConn().DescribeResourcePoliciesWithContext(ctx, &cloudwatchlogs.DescribeResourcePoliciesInput{})
+
+	if err != nil {
+		t.Fatalf("DescribeResourcePolicies: %s", err)
+	}
+
+	if got, want := aws.StringValue(describeOutput.ResourcePolicies[0].PolicyName), "test"; got != want {
+		t.Errorf("policy name = %s, want %s", got, want)
+	}
+
+	// The throttled request is retried.
+	requests := server.Requests("DescribeResourcePolicies")
+
+	if got, want := len(requests), 2; got != want {
+		t.Errorf("%d DescribeResourcePolicies requests, want %d", got, want)
+	}
+
+	if got, want := requests[0].Service, "logs"; got != want {
+		t.Errorf("service = %s, want %s", got, want)
+	}
+
+	// AWS query protocol.
+	server.Handle("GetRole", mockaws.QueryError(http.StatusNotFound, iam.ErrCodeNoSuchEntityException, "The role with name test cannot be found."))
+
+	_, err = client.IAMConn().GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		t.Errorf("GetRole: expected %s error, got: %v", iam.ErrCodeNoSuchEntityException, err)
+	}
+
+	if got, want := server.Requests("GetRole")[0].Form().Get("RoleName"), "test"; got != want {
+		t.Errorf("RoleName = %s, want %s", got, want)
+	}
+
+	// AWS REST-JSON protocol.
+	server.Handle("GET /2015-03-31/functions/*", mockaws.JSON(map[string]any{
+		"Configuration": map[string]any{"FunctionName": "test"},
+	}))
+
+	getFunctionOutput, err := client.LambdaConn().GetFunctionWithContext(ctx, &lambda.GetFunctionInput{FunctionName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("GetFunction: %s", err)
+	}
+
+	if got, want := aws.StringValue(getFunctionOutput.Configuration.FunctionName), "test"; got != want {
+		t.Errorf("function name = %s, want %s", got, want)
+	}
+}
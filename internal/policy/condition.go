@@ -0,0 +1,79 @@
+package policy
+
+import (
+	"strings"
+)
+
+const (
+	conditionQualifierForAllValues = "ForAllValues:"
+	conditionQualifierForAnyValue  = "ForAnyValue:"
+	conditionSuffixIfExists        = "IfExists"
+	conditionOperatorNull          = "Null"
+)
+
+// conditionOperators are the IAM condition operators without set qualifiers or the IfExists suffix.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var conditionOperators = map[string]struct{}{
+	"ArnEquals":                 {},
+	"ArnLike":                   {},
+	"ArnNotEquals":              {},
+	"ArnNotLike":                {},
+	"BinaryEquals":              {},
+	"Bool":                      {},
+	"DateEquals":                {},
+	"DateGreaterThan":           {},
+	"DateGreaterThanEquals":     {},
+	"DateLessThan":              {},
+	"DateLessThanEquals":        {},
+	"DateNotEquals":             {},
+	"IpAddress":                 {},
+	"NotIpAddress":              {},
+	"NumericEquals":             {},
+	"NumericGreaterThan":        {},
+	"NumericGreaterThanEquals":  {},
+	"NumericLessThan":           {},
+	"NumericLessThanEquals":     {},
+	"NumericNotEquals":          {},
+	"StringEquals":              {},
+	"StringEqualsIgnoreCase":    {},
+	"StringLike":                {},
+	"StringNotEquals":           {},
+	"StringNotEqualsIgnoreCase": {},
+	"StringNotLike":             {},
+}
+
+// ParseConditionOperator splits a condition operator such as "ForAnyValue:StringLikeIfExists"
+// into its base operator and modifiers.
+func ParseConditionOperator(test string) (operator string, forAllValues, forAnyValue, ifExists bool) {
+	operator = test
+
+	if v := strings.TrimPrefix(operator, conditionQualifierForAllValues); v != operator {
+		forAllValues, operator = true, v
+	} else if v := strings.TrimPrefix(operator, conditionQualifierForAnyValue); v != operator {
+		forAnyValue, operator = true, v
+	}
+
+	if operator == conditionOperatorNull {
+		return
+	}
+
+	if v := strings.TrimSuffix(operator, conditionSuffixIfExists); v != operator {
+		ifExists, operator = true, v
+	}
+
+	return
+}
+
+// IsConditionOperator returns whether test is a valid IAM condition operator,
+// including any set qualifier and the IfExists suffix.
+func IsConditionOperator(test string) bool {
+	operator, forAllValues, forAnyValue, ifExists := ParseConditionOperator(test)
+
+	if operator == conditionOperatorNull {
+		return !forAllValues && !forAnyValue && !ifExists
+	}
+
+	_, ok := conditionOperators[operator]
+
+	return ok
+}
@@ -0,0 +1,290 @@
+// Package policy contains a typed model of AWS access policy documents
+// (IAM identity and trust policies and the resource-based policies embedded in
+// many services) together with the normalization, merging and validation logic
+// shared by every policy-bearing attribute.
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	marshallJSONStartSliceSize = 2
+)
+
+type Document struct {
+	Version    string       `json:",omitempty"`
+	Id         string       `json:",omitempty"`
+	Statements []*Statement `json:"Statement,omitempty"`
+}
+
+type Statement struct {
+	Sid           string
+	Effect        string       `json:",omitempty"`
+	Actions       interface{}  `json:"Action,omitempty"`
+	NotActions    interface{}  `json:"NotAction,omitempty"`
+	Resources     interface{}  `json:"Resource,omitempty"`
+	NotResources  interface{}  `json:"NotResource,omitempty"`
+	Principals    PrincipalSet `json:"Principal,omitempty"`
+	NotPrincipals PrincipalSet `json:"NotPrincipal,omitempty"`
+	Conditions    ConditionSet `json:"Condition,omitempty"`
+}
+
+type Principal struct {
+	Type        string
+	Identifiers interface{}
+}
+
+type Condition struct {
+	Test     string
+	Variable string
+	Values   interface{}
+}
+
+type PrincipalSet []Principal
+type ConditionSet []Condition
+
+// UnmarshalJSON accepts either a single statement object or a list of statements
+// for the Statement element, as IAM does.
+func (s *Document) UnmarshalJSON(b []byte) error {
+	var data struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	var statements []*Statement
+
+	switch raw := bytes.TrimSpace(data.Statement); {
+	case len(raw) == 0, bytes.Equal(raw, []byte("null")):
+	case raw[0] == '{':
+		statement := &Statement{}
+		if err := json.Unmarshal(raw, statement); err != nil {
+			return err
+		}
+		statements = append(statements, statement)
+	default:
+		if err := json.Unmarshal(raw, &statements); err != nil {
+			return err
+		}
+	}
+
+	s.Version = data.Version
+	s.Id = data.Id
+	s.Statements = statements
+
+	return nil
+}
+
+// Merge merges newDoc into the document.
+// Statements in newDoc with non-blank Sids replace existing statements with the same Sid.
+func (s *Document) Merge(newDoc *Document) {
+	// adopt newDoc's Id
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	var seen bool
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+		seen = false
+		for i, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				s.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			s.Statements = append(s.Statements, newStatement)
+		}
+	}
+}
+
+func (ps PrincipalSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]interface{}{}
+
+	// Although IAM documentation says, that "*" and {"AWS": "*"} are equivalent
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html),
+	// in practice they are not for IAM roles. IAM will return an error if trust
+	// policy have "*" or {"*": "*"} as principal, but will accept {"AWS": "*"}.
+	// Only {"*": "*"} should be normalized to "*".
+	if len(ps) == 1 {
+		p := ps[0]
+		if p.Type == "*" {
+			if sv, ok := p.Identifiers.(string); ok && sv == "*" {
+				return []byte(`"*"`), nil
+			}
+
+			if av, ok := p.Identifiers.([]string); ok && len(av) == 1 && av[0] == "*" {
+				return []byte(`"*"`), nil
+			}
+		}
+	}
+
+	for _, p := range ps {
+		switch i := p.Identifiers.(type) {
+		case []string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = make([]string, 0, len(i))
+			case string:
+				// Convert to []string to prevent panic
+				raw[p.Type] = make([]string, 0, len(i)+1)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+			}
+			sort.Sort(sort.Reverse(sort.StringSlice(i)))
+			raw[p.Type] = append(raw[p.Type].([]string), i...)
+		case string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = i
+			case string:
+				// Convert to []string to stop drop of principals
+				raw[p.Type] = make([]string, 0, marshallJSONStartSliceSize)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			case []string:
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			}
+		default:
+			return []byte{}, fmt.Errorf("Unsupported data type %T for PrincipalSet", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (ps *PrincipalSet) UnmarshalJSON(b []byte) error {
+	var out PrincipalSet
+
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		out = append(out, Principal{Type: "*", Identifiers: []string{"*"}})
+	case map[string]interface{}:
+		for key, value := range data.(map[string]interface{}) {
+			switch vt := value.(type) {
+			case string:
+				out = append(out, Principal{Type: key, Identifiers: value.(string)})
+			case []interface{}:
+				values := []string{}
+				for _, v := range value.([]interface{}) {
+					values = append(values, v.(string))
+				}
+				out = append(out, Principal{Type: key, Identifiers: values})
+			default:
+				return fmt.Errorf("Unsupported data type %T for PrincipalSet.Identifiers", vt)
+			}
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for PrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
+func (cs ConditionSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]map[string]interface{}{}
+
+	for _, c := range cs {
+		if _, ok := raw[c.Test]; !ok {
+			raw[c.Test] = map[string]interface{}{}
+		}
+		switch i := c.Values.(type) {
+		case []string:
+			if _, ok := raw[c.Test][c.Variable]; !ok {
+				raw[c.Test][c.Variable] = make([]string, 0, len(i))
+			}
+			// order matters with values so not sorting here
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i...)
+		case string:
+			raw[c.Test][c.Variable] = i
+		default:
+			return nil, fmt.Errorf("Unsupported data type for ConditionSet: %s", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (cs *ConditionSet) UnmarshalJSON(b []byte) error {
+	var out ConditionSet
+
+	var data map[string]map[string]interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for test_key, test_value := range data {
+		for var_key, var_values := range test_value {
+			switch var_values := var_values.(type) {
+			case string:
+				out = append(out, Condition{Test: test_key, Variable: var_key, Values: []string{var_values}})
+			case bool:
+				out = append(out, Condition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case float64:
+				out = append(out, Condition{Test: test_key, Variable: var_key, Values: []string{strconv.FormatFloat(var_values, 'f', -1, 64)}})
+			case []interface{}:
+				values := []string{}
+				for _, v := range var_values {
+					switch v := v.(type) {
+					case string:
+						values = append(values, v)
+					case bool:
+						values = append(values, strconv.FormatBool(v))
+					case float64:
+						values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+					}
+				}
+				out = append(out, Condition{Test: test_key, Variable: var_key, Values: values})
+			}
+		}
+	}
+
+	*cs = out
+	return nil
+}
+
+// StringSlice flattens the string or list-of-strings representations used for
+// the Action, Resource, principal identifier and condition value elements.
+func StringSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+package policy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDocumentUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected *Document
+	}{
+		"statement list": {
+			input: `{"Version":"2012-10-17","Statement":[{"Sid":"1","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expected: &Document{
+				Version: "2012-10-17",
+				Statements: []*Statement{{
+					Sid:       "1",
+					Effect:    "Allow",
+					Actions:   "s3:GetObject",
+					Resources: "*",
+				}},
+			},
+		},
+		"single statement": {
+			input: `{"Id":"example","Statement":{"Effect":"Deny","NotAction":["iam:*","sts:*"],"NotResource":"*"}}`,
+			expected: &Document{
+				Id: "example",
+				Statements: []*Statement{{
+					Effect:       "Deny",
+					NotActions:   []interface{}{"iam:*", "sts:*"},
+					NotResources: "*",
+				}},
+			},
+		},
+		"no statement": {
+			input:    `{"Version":"2012-10-17"}`,
+			expected: &Document{Version: "2012-10-17"},
+		},
+		"principals and conditions": {
+			input: `{"Statement":[{"Effect":"Allow","Principal":"*","Condition":{"NumericLessThan":{"s3:max-keys":10},"Bool":{"aws:SecureTransport":true}}}]}`,
+			expected: &Document{
+				Statements: []*Statement{{
+					Effect:     "Allow",
+					Principals: PrincipalSet{{Type: "*", Identifiers: []string{"*"}}},
+				}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := &Document{}
+			if err := json.Unmarshal([]byte(testCase.input), doc); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Condition sets are built from maps so their order is not stable.
+			for _, stmt := range doc.Statements {
+				stmt.Conditions = nil
+			}
+
+			if diff := cmp.Diff(doc, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConditionSetUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var cs ConditionSet
+	if err := json.Unmarshal([]byte(`{"NumericLessThanEquals":{"s3:max-keys":[10,"20"]}}`), &cs); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := ConditionSet{{Test: "NumericLessThanEquals", Variable: "s3:max-keys", Values: []string{"10", "20"}}}
+
+	if diff := cmp.Diff(cs, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestDocumentMerge(t *testing.T) {
+	t.Parallel()
+
+	doc := &Document{
+		Version: "2008-10-17",
+		Statements: []*Statement{
+			{Sid: "A", Effect: "Allow", Actions: "s3:GetObject"},
+			{Effect: "Allow", Actions: "s3:ListBucket"},
+		},
+	}
+
+	doc.Merge(&Document{
+		Version: "2012-10-17",
+		Id:      "merged",
+		Statements: []*Statement{
+			{Sid: "A", Effect: "Deny", Actions: "s3:GetObject"},
+			{Sid: "B", Effect: "Allow", Actions: "s3:PutObject"},
+		},
+	})
+
+	expected := &Document{
+		Version: "2012-10-17",
+		Id:      "merged",
+		Statements: []*Statement{
+			{Sid: "A", Effect: "Deny", Actions: "s3:GetObject"},
+			{Effect: "Allow", Actions: "s3:ListBucket"},
+			{Sid: "B", Effect: "Allow", Actions: "s3:PutObject"},
+		},
+	}
+
+	if diff := cmp.Diff(doc, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestPrincipalSetMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    PrincipalSet
+		expected string
+	}{
+		"wildcard": {
+			input:    PrincipalSet{{Type: "*", Identifiers: "*"}},
+			expected: `"*"`,
+		},
+		"AWS wildcard": {
+			input:    PrincipalSet{{Type: "AWS", Identifiers: "*"}},
+			expected: `{"AWS":"*"}`,
+		},
+		"same type merged": {
+			input: PrincipalSet{
+				{Type: "AWS", Identifiers: "arn:aws:iam::123456789012:root"},
+				{Type: "AWS", Identifiers: []string{"arn:aws:iam::210987654321:root"}},
+			},
+			expected: `{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:root"]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := json.Marshal(testCase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := string(b), testCase.expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
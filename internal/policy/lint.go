@@ -0,0 +1,179 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LintRule identifies a policy lint check.
+type LintRule string
+
+const (
+	LintRuleDuplicateSid             LintRule = "duplicate-sid"
+	LintRuleMalformedResourceARN     LintRule = "malformed-resource-arn"
+	LintRulePublicPrincipal          LintRule = "public-principal"
+	LintRuleUnknownConditionOperator LintRule = "unknown-condition-operator"
+	LintRuleWildcardActionResource   LintRule = "wildcard-action-resource"
+)
+
+// LintRules returns all policy lint rules.
+func LintRules() []LintRule {
+	return []LintRule{
+		LintRuleDuplicateSid,
+		LintRuleMalformedResourceARN,
+		LintRulePublicPrincipal,
+		LintRuleUnknownConditionOperator,
+		LintRuleWildcardActionResource,
+	}
+}
+
+// LintFinding is a single problem found by Lint.
+type LintFinding struct {
+	Rule      LintRule
+	Statement int
+	Sid       string
+	Message   string
+}
+
+func (f LintFinding) String() string {
+	if f.Sid != "" {
+		return fmt.Sprintf("[%s] statement %d (%s): %s", f.Rule, f.Statement, f.Sid, f.Message)
+	}
+
+	return fmt.Sprintf("[%s] statement %d: %s", f.Rule, f.Statement, f.Message)
+}
+
+// LintConfig configures Lint.
+type LintConfig struct {
+	DisabledRules map[LintRule]struct{}
+}
+
+func (c *LintConfig) enabled(rule LintRule) bool {
+	if c == nil {
+		return true
+	}
+
+	_, ok := c.DisabledRules[rule]
+
+	return !ok
+}
+
+// Lint checks a policy document for constructs that are syntactically valid but
+// usually unintended, such as granting all actions on all resources.
+func Lint(doc *Document, config *LintConfig) []LintFinding {
+	var findings []LintFinding
+
+	add := func(rule LintRule, i int, stmt *Statement, format string, a ...any) {
+		if !config.enabled(rule) {
+			return
+		}
+
+		findings = append(findings, LintFinding{
+			Rule:      rule,
+			Statement: i,
+			Sid:       stmt.Sid,
+			Message:   fmt.Sprintf(format, a...),
+		})
+	}
+
+	sids := make(map[string]int)
+
+	for i, stmt := range doc.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		if stmt.Sid != "" {
+			if j, ok := sids[stmt.Sid]; ok {
+				add(LintRuleDuplicateSid, i, stmt, "Sid is also used by statement %d", j)
+			} else {
+				sids[stmt.Sid] = i
+			}
+		}
+
+		if stmt.Effect == EffectAllow {
+			if containsWildcard(StringSlice(stmt.Actions), "*", "*:*") && containsWildcard(StringSlice(stmt.Resources), "*") {
+				add(LintRuleWildcardActionResource, i, stmt, `allows all actions ("*") on all resources ("*")`)
+			}
+
+			if len(stmt.Conditions) == 0 && hasPublicPrincipal(stmt.Principals) {
+				add(LintRulePublicPrincipal, i, stmt, `allows any principal ("*") without a Condition`)
+			}
+		}
+
+		for _, c := range stmt.Conditions {
+			if !IsConditionOperator(c.Test) {
+				add(LintRuleUnknownConditionOperator, i, stmt, "unknown condition operator (%s)", c.Test)
+			}
+		}
+
+		for _, v := range append(StringSlice(stmt.Resources), StringSlice(stmt.NotResources)...) {
+			if !isResourceARN(v) {
+				add(LintRuleMalformedResourceARN, i, stmt, "resource (%s) is not a valid ARN", v)
+			}
+		}
+	}
+
+	return findings
+}
+
+// LintJSON parses and lints a JSON policy document.
+// Documents that cannot be parsed produce no findings.
+func LintJSON(policy string, config *LintConfig) []LintFinding {
+	if isEmpty(policy) {
+		return nil
+	}
+
+	doc, err := Parse(policy)
+
+	if err != nil {
+		return nil
+	}
+
+	return Lint(doc, config)
+}
+
+func containsWildcard(values []string, wildcards ...string) bool {
+	for _, v := range values {
+		for _, w := range wildcards {
+			if v == w {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func hasPublicPrincipal(principals PrincipalSet) bool {
+	for _, p := range principals {
+		if p.Type != "*" && p.Type != "AWS" {
+			continue
+		}
+
+		if containsWildcard(StringSlice(p.Identifiers), "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isResourceARN returns whether a Resource element value is "*" or has the
+// structure of an ARN: arn:partition:service:region:account-id:resource.
+// Wildcards and policy variables are permitted in any component.
+func isResourceARN(v string) bool {
+	if v == "*" {
+		return true
+	}
+
+	const arnSections = 6
+	parts := strings.SplitN(v, ":", arnSections)
+
+	if len(parts) != arnSections || parts[0] != "arn" {
+		return false
+	}
+
+	// Partition, service and resource are always required.
+	return parts[1] != "" && parts[2] != "" && parts[5] != ""
+}
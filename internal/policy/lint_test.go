@@ -0,0 +1,107 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLintJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		config   *LintConfig
+		expected []LintRule
+	}{
+		"empty": {
+			input: "",
+		},
+		"invalid JSON": {
+			input: `{"Statement":`,
+		},
+		"no findings": {
+			input: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-123"}}}]}`,
+		},
+		"wildcard action and resource": {
+			input:    `{"Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`,
+			expected: []LintRule{LintRuleWildcardActionResource},
+		},
+		"wildcard action and resource denied": {
+			input: `{"Statement":{"Effect":"Deny","Action":"*","Resource":"*"}}`,
+		},
+		"public principal": {
+			input:    `{"Statement":{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-west-2:123456789012:queue"}}`, //lintignore:AWSAT003,AWSAT005
+			expected: []LintRule{LintRulePublicPrincipal},
+		},
+		"public principal with condition": {
+			input: `{"Statement":{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-west-2:123456789012:topic"}}}}`, //lintignore:AWSAT003,AWSAT005
+		},
+		"unknown condition operator": {
+			input:    `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEqual":{"aws:username":"x"},"ForAnyValue:StringLikeIfExists":{"aws:TagKeys":"x"},"Null":{"aws:TokenIssueTime":"true"}}}}`,
+			expected: []LintRule{LintRuleUnknownConditionOperator},
+		},
+		"malformed resource ARN": {
+			input:    `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","NotResource":["arn:aws:s3:::bucket","bucket/*"]}}`,
+			expected: []LintRule{LintRuleMalformedResourceARN},
+		},
+		"duplicate Sid": {
+			input:    `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject"},{"Sid":"A","Effect":"Allow","Action":"s3:PutObject"}]}`,
+			expected: []LintRule{LintRuleDuplicateSid},
+		},
+		"disabled rules": {
+			input: `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			config: &LintConfig{
+				DisabledRules: map[LintRule]struct{}{LintRuleWildcardActionResource: {}},
+			},
+			expected: []LintRule{LintRuleDuplicateSid},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []LintRule
+			for _, finding := range LintJSON(testCase.input, testCase.config) {
+				got = append(got, finding.Rule)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConditionOperator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"StringEquals":                    true,
+		"StringEqualsIfExists":            true,
+		"ForAllValues:StringLike":         true,
+		"ForAnyValue:ArnNotLikeIfExists":  true,
+		"Null":                            true,
+		"NullIfExists":                    false,
+		"ForAllValues:Null":               false,
+		"StringEqual":                     false,
+		"ForSomeValues:StringEquals":      false,
+		"stringequals":                    false,
+		"IfExists":                        false,
+		"ForAnyValue:":                    false,
+		"ForAllValues:NumericLessThanEqu": false,
+	}
+
+	for test, expected := range testCases {
+		test, expected := test, expected
+		t.Run(test, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsConditionOperator(test); got != expected {
+				t.Errorf("IsConditionOperator(%q) = %t, expected %t", test, got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// Parse parses a JSON policy document.
+func Parse(policy string) (*Document, error) {
+	doc := &Document{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	return doc, nil
+}
+
+// isEmpty returns whether the policy is blank or an empty JSON object.
+func isEmpty(policy string) bool {
+	switch strings.TrimSpace(policy) {
+	case "", "{}":
+		return true
+	default:
+		return false
+	}
+}
+
+// Equivalent returns whether two JSON policy documents grant the same access.
+// Blank policies and empty JSON objects are equivalent to each other.
+func Equivalent(policy1, policy2 string) (bool, error) {
+	if isEmpty(policy1) && isEmpty(policy2) {
+		return true, nil
+	}
+
+	return awspolicy.PoliciesAreEquivalent(policy1, policy2)
+}
+
+// Normalize returns the canonical JSON form of a policy document.
+func Normalize(policy string) (string, error) {
+	return structure.NormalizeJsonString(policy)
+}
+
+// SecondUnlessEquivalent returns the old policy if the new policy is equivalent.
+// Otherwise, it returns the new policy.
+func SecondUnlessEquivalent(old, new string) (string, error) {
+	// valid empty JSON is "{}" not "" so handle special case to avoid
+	// Error unmarshaling policy: unexpected end of JSON input
+	if strings.TrimSpace(new) == "" {
+		return "", nil
+	}
+
+	if strings.TrimSpace(new) == "{}" {
+		return "{}", nil
+	}
+
+	if isEmpty(old) {
+		return new, nil
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+
+	if err != nil {
+		return "", err
+	}
+
+	if equivalent {
+		return old, nil
+	}
+
+	return new, nil
+}
+
+// ToSet returns the existing policy if the new policy is equivalent.
+// Otherwise, it returns the new policy. Either policy is normalized.
+func ToSet(exist, new string) (string, error) {
+	policyToSet, err := SecondUnlessEquivalent(exist, new)
+	if err != nil {
+		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
+	}
+
+	policyToSet, err = Normalize(policyToSet)
+	if err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
+	}
+
+	return policyToSet, nil
+}
+
+var legacyVersionRegexp = regexp.MustCompile(`(?s)^(\{\n?)(.*?)(,\s*)?(  )?("Version":\s*"2012-10-17")(,)?(\n)?(.*?)(\})`)
+
+// LegacyNormalize returns a "normalized" JSON policy document except
+// the Version element is first in the JSON as required by AWS in many places.
+// Version not being first is one reason for this error:
+// MalformedPolicyDocument: The policy failed legacy parsing
+func LegacyNormalize(policy interface{}) (string, error) {
+	if policy == nil || policy.(string) == "" {
+		return "", nil
+	}
+
+	np, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return policy.(string), fmt.Errorf("legacy policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	n := legacyVersionRegexp.ReplaceAllString(np, `$1$4$5$3$2$6$7$8$9`)
+
+	_, err = structure.NormalizeJsonString(n)
+	if err != nil {
+		return policy.(string), fmt.Errorf("LegacyNormalize created a policy (%s) that is invalid JSON: %w", n, err)
+	}
+
+	return n, nil
+}
+
+// LegacyToSet returns the existing policy if the new policy is equivalent.
+// Otherwise, it returns the new policy. Either policy is legacy normalized.
+func LegacyToSet(exist, new string) (string, error) {
+	policyToSet, err := SecondUnlessEquivalent(exist, new)
+	if err != nil {
+		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
+	}
+
+	policyToSet, err = LegacyNormalize(policyToSet)
+	if err != nil {
+		return "", fmt.Errorf("legacy policy (%s) is invalid JSON: %w", policyToSet, err)
+	}
+
+	return policyToSet, nil
+}
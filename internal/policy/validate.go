@@ -0,0 +1,81 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+
+	Version2008_10_17 = "2008-10-17"
+	Version2012_10_17 = "2012-10-17"
+)
+
+// Validate checks the structure of a policy document: a known Version, and
+// statements with a valid Effect, unique Sids and mutually exclusive
+// Action/NotAction, Resource/NotResource and Principal/NotPrincipal elements.
+func Validate(doc *Document) error {
+	var errs *multierror.Error
+
+	switch doc.Version {
+	case "", Version2008_10_17, Version2012_10_17:
+	default:
+		errs = multierror.Append(errs, fmt.Errorf("invalid Version (%s)", doc.Version))
+	}
+
+	sids := make(map[string]struct{})
+
+	for i, stmt := range doc.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		if err := validateStatement(stmt); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("statement %d: %w", i, err))
+		}
+
+		if stmt.Sid != "" {
+			if _, ok := sids[stmt.Sid]; ok {
+				errs = multierror.Append(errs, fmt.Errorf("statement %d: duplicate Sid (%s)", i, stmt.Sid))
+			}
+			sids[stmt.Sid] = struct{}{}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// ValidateJSON parses and validates a JSON policy document.
+func ValidateJSON(policy string) error {
+	doc, err := Parse(policy)
+
+	if err != nil {
+		return err
+	}
+
+	return Validate(doc)
+}
+
+func validateStatement(stmt *Statement) error {
+	switch stmt.Effect {
+	case EffectAllow, EffectDeny:
+	default:
+		return fmt.Errorf("invalid Effect (%s)", stmt.Effect)
+	}
+
+	if stmt.Actions != nil && stmt.NotActions != nil {
+		return errors.New("statement cannot contain both Action and NotAction")
+	}
+	if stmt.Resources != nil && stmt.NotResources != nil {
+		return errors.New("statement cannot contain both Resource and NotResource")
+	}
+	if len(stmt.Principals) > 0 && len(stmt.NotPrincipals) > 0 {
+		return errors.New("statement cannot contain both Principal and NotPrincipal")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package policy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input string
+		err   string
+	}{
+		"valid": {
+			input: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"B","Effect":"Deny","NotAction":"iam:*","NotResource":"*"}]}`,
+		},
+		"invalid JSON": {
+			input: `{"Statement":`,
+			err:   "parsing policy",
+		},
+		"invalid version": {
+			input: `{"Version":"2020-01-01","Statement":[]}`,
+			err:   "invalid Version",
+		},
+		"invalid effect": {
+			input: `{"Statement":{"Effect":"allow","Action":"*"}}`,
+			err:   "invalid Effect",
+		},
+		"action and not action": {
+			input: `{"Statement":[{"Effect":"Allow","Action":"*","NotAction":"iam:*"}]}`,
+			err:   "both Action and NotAction",
+		},
+		"resource and not resource": {
+			input: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","NotResource":"*"}]}`,
+			err:   "both Resource and NotResource",
+		},
+		"principal and not principal": {
+			input: `{"Statement":[{"Effect":"Allow","Action":"*","Principal":"*","NotPrincipal":{"AWS":"*"}}]}`,
+			err:   "both Principal and NotPrincipal",
+		},
+		"duplicate sid": {
+			input: `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"*"},{"Sid":"A","Effect":"Deny","Action":"*"}]}`,
+			err:   "duplicate Sid (A)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateJSON(testCase.input)
+
+			if testCase.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.err) {
+				t.Errorf("expected error containing %q, got: %v", testCase.err, err)
+			}
+		})
+	}
+}
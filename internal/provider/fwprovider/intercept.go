@@ -2,20 +2,22 @@ package fwprovider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/intercept"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -28,7 +30,8 @@ type resourceCRUDResponse interface {
 
 // A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
-// no further interceptors in the chain are run and neither is the schema's method.
+// no further interceptors in the chain are run and neither is the schema's method,
+// but the OnError and Finally interceptors earlier in the chain are run.
 // In other cases all interceptors in the chain are run.
 type resourceInterceptor interface {
 	// create is invoke for a Create call.
@@ -41,6 +44,11 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// A resource plan modifier interceptor is optional functionality invoked after the resource's ModifyPlan method, if any.
+type resourcePlanModifierInterceptor interface {
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -75,13 +83,13 @@ func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.Delete
 
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
-type when uint16
+type when = intercept.When
 
 const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Before  = intercept.Before  // Interceptor is invoked before call to method in schema
+	After   = intercept.After   // Interceptor is invoked after successful call to method in schema
+	OnError = intercept.OnError // Interceptor is invoked after unsuccessful call to method in schema
+	Finally = intercept.Finally // Interceptor is invoked after After or OnError
 )
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
@@ -90,20 +98,25 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 		var diags diag.Diagnostics
 		// Before interceptors are run first to last.
 		forward := interceptors
+		shortCircuited := false
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Interceptors earlier in the chain still see the operation fail.
 			if diags.HasError() {
-				return diags
+				forward, shortCircuited = forward[:i], true
+				break
 			}
 		}
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, request, response)
+		if !shortCircuited {
+			diags = f(ctx, request, response)
+		}
 
 		if diags.HasError() {
 			when = OnError
@@ -132,12 +145,18 @@ type wrappedDataSource struct {
 	bootstrapContext contextFunc
 	inner            datasource.DataSourceWithConfigure
 	meta             *conns.AWSClient
+	// policyLint is added to the validators of the data source's policy document attributes.
+	policyLint validator.String
+	// region is whether the provider adds the `region` attribute to the data source's schema.
+	region bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, policyLint validator.String, region bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
+		policyLint:       policyLint,
+		region:           region,
 	}
 }
 
@@ -149,18 +168,70 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.policyLint != nil {
+		addDataSourcePolicyLintValidation(&response.Schema, w.policyLint)
+	}
+	if w.region {
+		addDataSourceRegionAttribute(&response.Schema)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Read(ctx, request, response)
+
+	if !w.region {
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	ctx, meta, diags := forRegion(ctx, w.meta, regionString(regionValue(request.Config.Raw)))
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+	w.configureInner(ctx, meta)
+
+	var schemaResponse datasource.SchemaResponse
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+	regionless := newDataSourceRegionless(ctx, schemaResponse.Schema)
+
+	innerRequest := datasource.ReadRequest{
+		Config:       regionless.config(request.Config, &response.Diagnostics),
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := datasource.ReadResponse{
+		State: regionless.state(response.State, &response.Diagnostics),
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	// Data sources report the Region that they were read from.
+	regionless.restoreState(&response.State, innerResponse.State, metaRegion(meta), &response.Diagnostics)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
+// configureInner configures the wrapped data source with the provider Meta for another Region.
+func (w *wrappedDataSource) configureInner(ctx context.Context, meta *conns.AWSClient) {
+	if meta == nil || meta == w.meta {
+		return
+	}
+
+	var response datasource.ConfigureResponse
+	w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &response)
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -168,13 +239,19 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// policyLint is added to the validators of the resource's policy document attributes.
+	policyLint validator.String
+	// region is whether the provider adds the `region` attribute to the resource's schema.
+	region bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, policyLint validator.String, region bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		policyLint:       policyLint,
+		region:           region,
 	}
 }
 
@@ -186,6 +263,13 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.policyLint != nil {
+		addResourcePolicyLintValidation(&response.Schema, w.policyLint)
+	}
+	if w.region {
+		addResourceRegionAttribute(&response.Schema)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -194,8 +278,38 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+
+	if !w.region {
+		diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	ctx, meta, diags := w.forRegion(ctx, regionValue(request.Plan.Raw))
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	regionless := w.regionless(ctx)
+	innerRequest := resource.CreateRequest{
+		Config:       regionless.config(request.Config, &response.Diagnostics),
+		Plan:         regionless.plan(request.Plan, &response.Diagnostics),
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.CreateResponse{
+		State:   regionless.state(response.State, &response.Diagnostics),
+		Private: response.Private,
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.create(), f, meta)(ctx, innerRequest, &innerResponse)
+
 	response.Diagnostics = diags
+	response.Private = innerResponse.Private
+	regionless.restoreState(&response.State, innerResponse.State, metaRegion(meta), &response.Diagnostics)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -204,8 +318,39 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+
+	if !w.region {
+		diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	ctx, meta, diags := w.forRegion(ctx, regionValue(request.State.Raw))
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	regionless := w.regionless(ctx)
+	innerRequest := resource.ReadRequest{
+		State:        regionless.state(request.State, &response.Diagnostics),
+		Private:      request.Private,
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.ReadResponse{
+		State:   regionless.state(response.State, &response.Diagnostics),
+		Private: response.Private,
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.read(), f, meta)(ctx, innerRequest, &innerResponse)
+
 	response.Diagnostics = diags
+	response.Private = innerResponse.Private
+	// The Region that the resource is managed in is stored in state.
+	regionless.restoreState(&response.State, innerResponse.State, metaRegion(meta), &response.Diagnostics)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -214,8 +359,40 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+
+	if !w.region {
+		diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	ctx, meta, diags := w.forRegion(ctx, regionValue(request.Plan.Raw))
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	regionless := w.regionless(ctx)
+	innerRequest := resource.UpdateRequest{
+		Config:       regionless.config(request.Config, &response.Diagnostics),
+		Plan:         regionless.plan(request.Plan, &response.Diagnostics),
+		State:        regionless.state(request.State, &response.Diagnostics),
+		ProviderMeta: request.ProviderMeta,
+		Private:      request.Private,
+	}
+	innerResponse := resource.UpdateResponse{
+		State:   regionless.state(response.State, &response.Diagnostics),
+		Private: response.Private,
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.update(), f, meta)(ctx, innerRequest, &innerResponse)
+
 	response.Diagnostics = diags
+	response.Private = innerResponse.Private
+	regionless.restoreState(&response.State, innerResponse.State, metaRegion(meta), &response.Diagnostics)
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -224,8 +401,37 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+
+	if !w.region {
+		diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	region := regionValue(request.State.Raw)
+	ctx, meta, diags := w.forRegion(ctx, region)
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	regionless := w.regionless(ctx)
+	innerRequest := resource.DeleteRequest{
+		State:        regionless.state(request.State, &response.Diagnostics),
+		ProviderMeta: request.ProviderMeta,
+		Private:      request.Private,
+	}
+	innerResponse := resource.DeleteResponse{
+		State: regionless.state(response.State, &response.Diagnostics),
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.delete(), f, meta)(ctx, innerRequest, &innerResponse)
+
 	response.Diagnostics = diags
+	regionless.restoreState(&response.State, innerResponse.State, region, &response.Diagnostics)
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -237,26 +443,112 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+	v, ok := w.inner.(resource.ResourceWithImportState)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+
+		return
+	}
+
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if !w.region {
 		v.ImportState(ctx, request, response)
 
 		return
 	}
 
-	response.Diagnostics.AddError(
-		"Resource Import Not Implemented",
-		"This resource does not support import. Please contact the provider developer for additional information.",
-	)
+	// The ID may be suffixed with the Region that the resource is imported from.
+	id, region := parseImportID(request.ID)
+	ctx, meta, diags := w.forRegion(ctx, tftypes.NewValue(tftypes.String, region))
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	regionless := w.regionless(ctx)
+	innerResponse := resource.ImportStateResponse{
+		State:   regionless.state(response.State, &response.Diagnostics),
+		Private: response.Private,
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.ImportState(ctx, resource.ImportStateRequest{ID: id}, &innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+	regionless.restoreState(&response.State, innerResponse.State, metaRegion(meta), &response.Diagnostics)
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		if w.region {
+			w.modifyRegionlessPlan(ctx, v, request, response)
+		} else {
+			v.ModifyPlan(ctx, request, response)
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if w.region {
+		response.Diagnostics.Append(modifyRegionPlan(ctx, request.Config, request.State, &response.Plan, w.meta, &response.RequiresReplace)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Run any interceptors that modify the plan.
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourcePlanModifierInterceptor); ok {
+			ctx, response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+}
 
+// modifyRegionlessPlan runs the wrapped resource's ModifyPlan method with values in the resource's own schema.
+func (w *wrappedResource) modifyRegionlessPlan(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx, _, diags := w.forRegion(ctx, regionValue(request.Plan.Raw))
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
 		return
 	}
+
+	regionless := w.regionless(ctx)
+	innerRequest := resource.ModifyPlanRequest{
+		Config:       regionless.config(request.Config, &response.Diagnostics),
+		State:        regionless.state(request.State, &response.Diagnostics),
+		Plan:         regionless.plan(request.Plan, &response.Diagnostics),
+		ProviderMeta: request.ProviderMeta,
+		Private:      request.Private,
+	}
+	innerResponse := resource.ModifyPlanResponse{
+		Plan:            regionless.plan(response.Plan, &response.Diagnostics),
+		RequiresReplace: response.RequiresReplace,
+		Private:         response.Private,
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.RequiresReplace = innerResponse.RequiresReplace
+	response.Private = innerResponse.Private
+	regionless.restorePlan(&response.Plan, innerResponse.Plan, regionValue(response.Plan.Raw), &response.Diagnostics)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -271,6 +563,16 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.region {
+			request = resource.ValidateConfigRequest{
+				Config: w.regionless(ctx).config(request.Config, &response.Diagnostics),
+			}
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -278,265 +580,268 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
 
-		return v.UpgradeState(ctx)
+		if w.region {
+			for k, v := range upgraders {
+				upgraders[k] = w.regionlessStateUpgrader(ctx, v)
+			}
+		}
+
+		return upgraders
 	}
 
 	return nil
 }
 
-// tagsInterceptor implements transparent tagging.
-type tagsInterceptor struct {
-	tags *types.ServicePackageResourceTags
-}
+// regionlessStateUpgrader returns a state upgrader that runs the wrapped resource's state upgrader with values in the resource's own schemas.
+func (w *wrappedResource) regionlessStateUpgrader(ctx context.Context, upgrader resource.StateUpgrader) resource.StateUpgrader {
+	var prior *regionless
 
-func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	if r.tags == nil {
-		return ctx, diags
+	if v := upgrader.PriorSchema; v != nil {
+		r := newResourceRegionless(ctx, *v)
+		prior = &r
+
+		s := *v
+		addResourceRegionAttribute(&s)
+		upgrader.PriorSchema = &s
 	}
 
-	inContext, ok := conns.FromContext(ctx)
-	if !ok {
-		return ctx, diags
+	f := upgrader.StateUpgrader
+	upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		region := tftypes.NewValue(tftypes.String, nil)
+		innerRequest := resource.UpgradeStateRequest{
+			RawState: request.RawState,
+		}
+
+		if prior != nil && request.State != nil {
+			region = regionValue(request.State.Raw)
+			state := prior.state(*request.State, &response.Diagnostics)
+			innerRequest.State = &state
+		}
+
+		regionless := w.regionless(ctx)
+		innerResponse := resource.UpgradeStateResponse{
+			State: regionless.state(response.State, &response.Diagnostics),
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		f(ctx, innerRequest, &innerResponse)
+
+		response.Diagnostics.Append(innerResponse.Diagnostics...)
+		response.DynamicValue = innerResponse.DynamicValue
+		regionless.restoreState(&response.State, innerResponse.State, region, &response.Diagnostics)
 	}
 
-	tagsInContext, ok := tftags.FromContext(ctx)
-	if !ok {
-		return ctx, diags
+	return upgrader
+}
+
+// forRegion returns the provider Meta for the Region in a `region` attribute value, configuring the wrapped resource to use it.
+func (w *wrappedResource) forRegion(ctx context.Context, region tftypes.Value) (context.Context, *conns.AWSClient, diag.Diagnostics) {
+	ctx, meta, diags := forRegion(ctx, w.meta, regionString(region))
+	if diags.HasError() {
+		return ctx, meta, diags
 	}
 
-	switch when {
-	case Before:
-		var planTags fwtypes.Map
-		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if meta != nil && meta != w.meta {
+		var response resource.ConfigureResponse
+		w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &response)
+		diags.Append(response.Diagnostics...)
+	}
 
-		if diags.HasError() {
-			return ctx, diags
-		}
+	return ctx, meta, diags
+}
 
-		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
-		// Remove system tags.
-		tags = tags.IgnoreSystem(inContext.ServicePackageName)
+// regionless returns the converter between the resource's schema with the `region` attribute and its own schema.
+func (w *wrappedResource) regionless(ctx context.Context) regionless {
+	var response resource.SchemaResponse
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
 
-		tagsInContext.TagsIn = types.Some(tags)
-	case After:
-		// Set values for unknowns.
-		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
-		// Computed tags_all include any provider configured default_tags.
-		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.TagsIn.MustUnwrap().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
-		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+	return newResourceRegionless(ctx, response.Schema)
+}
 
-		if diags.HasError() {
-			return ctx, diags
+// frameworkResourceData adapts Plugin Framework plan and state to the common interceptor ResourceData.
+type frameworkResourceData struct {
+	plan     *tfsdk.Plan  // Planned new state; nil on Read and Delete
+	state    *tfsdk.State // Prior state; nil on Create
+	newState *tfsdk.State // New state
+}
+
+func (d frameworkResourceData) Id() string {
+	var id fwtypes.String
+
+	for _, v := range []*tfsdk.State{d.newState, d.state} {
+		if v == nil || v.Raw.IsNull() {
+			continue
+		}
+
+		if diags := v.GetAttribute(context.Background(), path.Root(names.AttrID), &id); !diags.HasError() && id.ValueString() != "" {
+			return id.ValueString()
 		}
 	}
 
-	return ctx, diags
+	return ""
 }
 
-func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	if r.tags == nil {
-		return ctx, diags
-	}
+func (d frameworkResourceData) Exists() bool {
+	return d.newState != nil && !d.newState.Raw.IsNull()
+}
 
-	inContext, ok := conns.FromContext(ctx)
-	if !ok {
-		return ctx, diags
+// ReadAfterWrite returns false as Plugin Framework resources' C & U handlers don't call the R handler.
+func (d frameworkResourceData) ReadAfterWrite() bool {
+	return false
+}
+
+func (d frameworkResourceData) GetString(ctx context.Context, name string) (string, error) {
+	var v fwtypes.String
+	var diags diag.Diagnostics
+
+	switch {
+	case d.plan != nil:
+		diags = d.plan.GetAttribute(ctx, path.Root(name), &v)
+	case d.newState != nil && !d.newState.Raw.IsNull():
+		diags = d.newState.GetAttribute(ctx, path.Root(name), &v)
+	case d.state != nil:
+		diags = d.state.GetAttribute(ctx, path.Root(name), &v)
 	}
 
-	sp, ok := meta.ServicePackages[inContext.ServicePackageName]
-	if !ok {
-		return ctx, diags
+	if err := fwdiag.DiagnosticsError(diags); err != nil {
+		return "", err
 	}
 
-	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
-	if err != nil {
-		serviceName = "<service>"
+	return v.ValueString(), nil
+}
+
+func (d frameworkResourceData) GetTags(ctx context.Context) (tftags.KeyValueTags, error) {
+	if d.plan == nil {
+		return tftags.New(ctx, nil), nil
 	}
 
-	resourceName := inContext.ResourceName
-	if resourceName == "" {
-		resourceName = "<thing>"
+	var planTags fwtypes.Map
+	if err := fwdiag.DiagnosticsError(d.plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)); err != nil {
+		return nil, err
 	}
 
-	tagsInContext, ok := tftags.FromContext(ctx)
-	if !ok {
-		return ctx, diags
+	return tftags.New(ctx, planTags), nil
+}
+
+func (d frameworkResourceData) GetTagsAllChange(ctx context.Context) (any, any, bool, error) {
+	var oldTagsAll, newTagsAll fwtypes.Map
+
+	if d.state != nil {
+		if err := fwdiag.DiagnosticsError(d.state.GetAttribute(ctx, path.Root(names.AttrTagsAll), &oldTagsAll)); err != nil {
+			return nil, nil, false, err
+		}
 	}
 
-	switch when {
-	case After:
-		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
-		if response.State.Raw.IsNull() {
-			return ctx, diags
+	if d.plan != nil {
+		if err := fwdiag.DiagnosticsError(d.plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &newTagsAll)); err != nil {
+			return nil, nil, false, err
 		}
+	}
 
-		// If the R handler didn't set tags, try and read them from the service API.
-		if tagsInContext.TagsOut.IsNone() {
-			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
-				var identifier string
+	return oldTagsAll, newTagsAll, !newTagsAll.Equal(oldTagsAll), nil
+}
 
-				diags.Append(response.State.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
+func (d frameworkResourceData) SetTags(ctx context.Context, tags map[string]string) error {
+	// AWS APIs often return empty lists of tags when none have been configured.
+	stateTags := tftags.Null
+	if len(tags) > 0 {
+		stateTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags)
+	}
 
-				if diags.HasError() {
-					return ctx, diags
-				}
+	return fwdiag.DiagnosticsError(d.newState.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags))
+}
 
-				// If the service package has a generic resource list tags methods, call it.
-				var err error
+func (d frameworkResourceData) SetTagsAll(ctx context.Context, tags map[string]string) error {
+	stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, tags)
 
-				if v, ok := sp.(interface {
-					ListTags(context.Context, any, string) error
-				}); ok {
-					err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
-				} else if v, ok := sp.(interface {
-					ListTags(context.Context, any, string, string) error
-				}); ok && r.tags.ResourceType != "" {
-					err = v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
-				}
+	return fwdiag.DiagnosticsError(d.newState.SetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll))
+}
 
-				if err != nil {
-					diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+// commonInterceptor runs a common resource interceptor for a Plugin Framework resource.
+type commonInterceptor struct {
+	interceptor intercept.ResourceInterceptor
+}
 
-					return ctx, diags
-				}
-			}
-		}
+func (r commonInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, frameworkResourceData{plan: &request.Plan, newState: &response.State}, meta, when, intercept.Create, diags)
+}
 
-		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
+func (r commonInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, frameworkResourceData{state: &request.State, newState: &response.State}, meta, when, intercept.Read, diags)
+}
 
-		// AWS APIs often return empty lists of tags when none have been configured.
-		stateTags := tftags.Null
-		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-		// The resource's configured tags do not include any provider configured default_tags.
-		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).RemoveDefaultConfig(tagsInContext.DefaultConfig).Map(); len(v) > 0 {
-			stateTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
-		}
-		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
+func (r commonInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, frameworkResourceData{plan: &request.Plan, state: &request.State, newState: &response.State}, meta, when, intercept.Update, diags)
+}
 
-		if diags.HasError() {
-			return ctx, diags
-		}
+func (r commonInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, frameworkResourceData{state: &request.State, newState: &response.State}, meta, when, intercept.Delete, diags)
+}
 
-		// Computed tags_all do.
-		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
-		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+func (r commonInterceptor) run(ctx context.Context, d frameworkResourceData, meta *conns.AWSClient, when when, why intercept.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	ctx, newDiags := r.interceptor.Run(ctx, d, meta, when, why, fwdiag.DiagnosticsError(diags))
 
-		if diags.HasError() {
-			return ctx, diags
+	for _, v := range newDiags {
+		if v.Severity == intercept.SeverityWarning {
+			diags.AddWarning(v.Summary, v.Detail)
+		} else {
+			diags.AddError(v.Summary, v.Detail)
 		}
 	}
 
 	return ctx, diags
 }
 
-func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	if r.tags == nil {
-		return ctx, diags
-	}
+// tagsInterceptor implements transparent tagging.
+type tagsInterceptor struct {
+	commonInterceptor
+	tags *types.ServicePackageResourceTags
+}
 
-	inContext, ok := conns.FromContext(ctx)
-	if !ok {
-		return ctx, diags
+func newTagsInterceptor(tags *types.ServicePackageResourceTags) tagsInterceptor {
+	return tagsInterceptor{
+		commonInterceptor: commonInterceptor{interceptor: intercept.NewTagsInterceptor(tags)},
+		tags:              tags,
 	}
+}
 
-	sp, ok := meta.ServicePackages[inContext.ServicePackageName]
-	if !ok {
+func (r tagsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil || !r.tags.ForceNew() {
 		return ctx, diags
 	}
 
-	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
-	if err != nil {
-		serviceName = "<service>"
-	}
-
-	resourceName := inContext.ResourceName
-	if resourceName == "" {
-		resourceName = "<thing>"
-	}
-
-	tagsInContext, ok := tftags.FromContext(ctx)
-	if !ok {
+	// Nothing to do on resource Create or Delete.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
 		return ctx, diags
 	}
 
-	switch when {
-	case Before:
-		var planTags fwtypes.Map
-		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
-
-		if diags.HasError() {
-			return ctx, diags
-		}
-
-		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
-		// Remove system tags.
-		tags = tags.IgnoreSystem(inContext.ServicePackageName)
+	// A change to the resource's tags requires the resource to be replaced.
+	for _, v := range []string{names.AttrTags, names.AttrTagsAll} {
+		var planTags, stateTags fwtypes.Map
 
-		tagsInContext.TagsIn = types.Some(tags)
-
-		var oldTagsAll, newTagsAll fwtypes.Map
-
-		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &oldTagsAll)...)
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(v), &planTags)...)
 
 		if diags.HasError() {
 			return ctx, diags
 		}
 
-		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &newTagsAll)...)
+		diags.Append(request.State.GetAttribute(ctx, path.Root(v), &stateTags)...)
 
 		if diags.HasError() {
 			return ctx, diags
 		}
 
-		if !newTagsAll.Equal(oldTagsAll) {
-			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
-				var identifier string
-
-				diags.Append(request.Plan.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
-
-				if diags.HasError() {
-					return ctx, diags
-				}
-
-				// If the service package has a generic resource update tags methods, call it.
-				var err error
-
-				if v, ok := sp.(interface {
-					UpdateTags(context.Context, any, string, any, any) error
-				}); ok {
-					err = v.UpdateTags(ctx, meta, identifier, oldTagsAll, newTagsAll)
-				} else if v, ok := sp.(interface {
-					UpdateTags(context.Context, any, string, string, any, any) error
-				}); ok && r.tags.ResourceType != "" {
-					err = v.UpdateTags(ctx, meta, identifier, r.tags.ResourceType, oldTagsAll, newTagsAll)
-				}
-
-				if verify.ErrorISOUnsupported(meta.Partition, err) {
-					// ISO partitions may not support tagging, giving error
-					tflog.Warn(ctx, "failed updating tags for resource", map[string]interface{}{
-						r.tags.IdentifierAttribute: identifier,
-						"error":                    err.Error(),
-					})
-
-					return ctx, diags
-				}
-
-				if err != nil {
-					diags.AddError(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
-
-					return ctx, diags
-				}
-			}
-			// TODO If the only change was to tags it would be nice to not call the resource's U handler.
+		// Computed tags_all may be unknown in the plan even if the configured tags have not changed.
+		if planTags.Equal(stateTags) || (v == names.AttrTagsAll && planTags.IsUnknown()) {
+			continue
 		}
-	}
 
-	return ctx, diags
-}
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(v))
+	}
 
-func (r tagsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
@@ -0,0 +1,200 @@
+package fwprovider
+
+import (
+	"context"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+// policyLintValidator reports the provider's policy lint findings for a policy document attribute as warnings.
+// Lint findings depend on the provider's policy_lint configuration so are only
+// reported once the provider has been configured.
+type policyLintValidator struct {
+	primary interface{ Meta() interface{} }
+}
+
+func newPolicyLintValidator(primary interface{ Meta() interface{} }) validator.String {
+	return policyLintValidator{primary: primary}
+}
+
+func (v policyLintValidator) Description(_ context.Context) string {
+	return "policy document is linted"
+}
+
+func (v policyLintValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyLintValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	meta, ok := v.primary.Meta().(*conns.AWSClient)
+
+	// The provider is not yet configured.
+	if !ok || meta.PolicyLintConfig == nil {
+		return
+	}
+
+	for _, finding := range tfpolicy.LintJSON(request.ConfigValue.ValueString(), meta.PolicyLintConfig) {
+		response.Diagnostics.AddAttributeWarning(request.Path, "Policy document lint finding", finding.String())
+	}
+}
+
+// isPolicyDocumentAttribute returns whether the validators of a configurable string attribute
+// indicate that it holds a policy document, i.e. it is validated with fwvalidators.IAMPolicyJSON.
+func isPolicyDocumentAttribute(computed, optional, required bool, validators []validator.String) bool {
+	if computed && !optional && !required {
+		return false
+	}
+
+	for _, v := range validators {
+		if fwvalidators.IsIAMPolicyJSON(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addResourcePolicyLintValidation adds policy lint warnings to the validation of every
+// policy document attribute in a resource schema, including nested attributes and blocks.
+func addResourcePolicyLintValidation(s *rschema.Schema, lint validator.String) {
+	s.Attributes = resourceAttributesWithPolicyLint(s.Attributes, lint)
+	s.Blocks = resourceBlocksWithPolicyLint(s.Blocks, lint)
+}
+
+func resourceAttributesWithPolicyLint(m map[string]rschema.Attribute, lint validator.String) map[string]rschema.Attribute {
+	if m == nil {
+		return nil
+	}
+
+	attributes := make(map[string]rschema.Attribute, len(m))
+	for k, v := range m {
+		switch v := v.(type) {
+		case rschema.StringAttribute:
+			if isPolicyDocumentAttribute(v.Computed, v.Optional, v.Required, v.Validators) {
+				v.Validators = append(v.Validators[:len(v.Validators):len(v.Validators)], lint)
+			}
+			attributes[k] = v
+		case rschema.ListNestedAttribute:
+			v.NestedObject.Attributes = resourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			attributes[k] = v
+		case rschema.SetNestedAttribute:
+			v.NestedObject.Attributes = resourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			attributes[k] = v
+		case rschema.MapNestedAttribute:
+			v.NestedObject.Attributes = resourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			attributes[k] = v
+		case rschema.SingleNestedAttribute:
+			v.Attributes = resourceAttributesWithPolicyLint(v.Attributes, lint)
+			attributes[k] = v
+		default:
+			attributes[k] = v
+		}
+	}
+
+	return attributes
+}
+
+func resourceBlocksWithPolicyLint(m map[string]rschema.Block, lint validator.String) map[string]rschema.Block {
+	if m == nil {
+		return nil
+	}
+
+	blocks := make(map[string]rschema.Block, len(m))
+	for k, v := range m {
+		switch v := v.(type) {
+		case rschema.ListNestedBlock:
+			v.NestedObject.Attributes = resourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			v.NestedObject.Blocks = resourceBlocksWithPolicyLint(v.NestedObject.Blocks, lint)
+			blocks[k] = v
+		case rschema.SetNestedBlock:
+			v.NestedObject.Attributes = resourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			v.NestedObject.Blocks = resourceBlocksWithPolicyLint(v.NestedObject.Blocks, lint)
+			blocks[k] = v
+		case rschema.SingleNestedBlock:
+			v.Attributes = resourceAttributesWithPolicyLint(v.Attributes, lint)
+			v.Blocks = resourceBlocksWithPolicyLint(v.Blocks, lint)
+			blocks[k] = v
+		default:
+			blocks[k] = v
+		}
+	}
+
+	return blocks
+}
+
+// addDataSourcePolicyLintValidation adds policy lint warnings to the validation of every
+// policy document attribute in a data source schema, including nested attributes and blocks.
+func addDataSourcePolicyLintValidation(s *dschema.Schema, lint validator.String) {
+	s.Attributes = dataSourceAttributesWithPolicyLint(s.Attributes, lint)
+	s.Blocks = dataSourceBlocksWithPolicyLint(s.Blocks, lint)
+}
+
+func dataSourceAttributesWithPolicyLint(m map[string]dschema.Attribute, lint validator.String) map[string]dschema.Attribute {
+	if m == nil {
+		return nil
+	}
+
+	attributes := make(map[string]dschema.Attribute, len(m))
+	for k, v := range m {
+		switch v := v.(type) {
+		case dschema.StringAttribute:
+			if isPolicyDocumentAttribute(v.Computed, v.Optional, v.Required, v.Validators) {
+				v.Validators = append(v.Validators[:len(v.Validators):len(v.Validators)], lint)
+			}
+			attributes[k] = v
+		case dschema.ListNestedAttribute:
+			v.NestedObject.Attributes = dataSourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			attributes[k] = v
+		case dschema.SetNestedAttribute:
+			v.NestedObject.Attributes = dataSourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			attributes[k] = v
+		case dschema.MapNestedAttribute:
+			v.NestedObject.Attributes = dataSourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			attributes[k] = v
+		case dschema.SingleNestedAttribute:
+			v.Attributes = dataSourceAttributesWithPolicyLint(v.Attributes, lint)
+			attributes[k] = v
+		default:
+			attributes[k] = v
+		}
+	}
+
+	return attributes
+}
+
+func dataSourceBlocksWithPolicyLint(m map[string]dschema.Block, lint validator.String) map[string]dschema.Block {
+	if m == nil {
+		return nil
+	}
+
+	blocks := make(map[string]dschema.Block, len(m))
+	for k, v := range m {
+		switch v := v.(type) {
+		case dschema.ListNestedBlock:
+			v.NestedObject.Attributes = dataSourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			v.NestedObject.Blocks = dataSourceBlocksWithPolicyLint(v.NestedObject.Blocks, lint)
+			blocks[k] = v
+		case dschema.SetNestedBlock:
+			v.NestedObject.Attributes = dataSourceAttributesWithPolicyLint(v.NestedObject.Attributes, lint)
+			v.NestedObject.Blocks = dataSourceBlocksWithPolicyLint(v.NestedObject.Blocks, lint)
+			blocks[k] = v
+		case dschema.SingleNestedBlock:
+			v.Attributes = dataSourceAttributesWithPolicyLint(v.Attributes, lint)
+			v.Blocks = dataSourceBlocksWithPolicyLint(v.Blocks, lint)
+			blocks[k] = v
+		default:
+			blocks[k] = v
+		}
+	}
+
+	return blocks
+}
@@ -0,0 +1,132 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+type testPrimary struct {
+	meta interface{}
+}
+
+func (p testPrimary) Meta() interface{} {
+	return p.meta
+}
+
+func TestAddResourcePolicyLintValidation(t *testing.T) {
+	t.Parallel()
+
+	lint := newPolicyLintValidator(testPrimary{})
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"policy": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{fwvalidators.IAMPolicyJSON()},
+			},
+			"computed_policy": schema.StringAttribute{
+				Computed:   true,
+				Validators: []validator.String{fwvalidators.IAMPolicyJSON()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"document": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{fwvalidators.IAMPolicyJSON()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	addResourcePolicyLintValidation(&s, lint)
+
+	linted := func(v schema.Attribute) bool {
+		for _, v := range v.(schema.StringAttribute).Validators {
+			if v == lint {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if linted(s.Attributes["name"]) {
+		t.Error("name is linted")
+	}
+	if !linted(s.Attributes["policy"]) {
+		t.Error("policy is not linted")
+	}
+	if linted(s.Attributes["computed_policy"]) {
+		t.Error("computed_policy is linted")
+	}
+	if !linted(s.Blocks["statement"].(schema.ListNestedBlock).NestedObject.Attributes["document"]) {
+		t.Error("statement.document is not linted")
+	}
+}
+
+func TestPolicyLintValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := types.StringValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`)
+
+	testCases := []struct {
+		Name             string
+		Meta             interface{}
+		ExpectedWarnings bool
+	}{
+		{
+			Name: "not configured",
+		},
+		{
+			Name:             "configured",
+			Meta:             &conns.AWSClient{PolicyLintConfig: &tfpolicy.LintConfig{}},
+			ExpectedWarnings: true,
+		},
+		{
+			Name: "rule disabled",
+			Meta: &conns.AWSClient{PolicyLintConfig: &tfpolicy.LintConfig{
+				DisabledRules: map[tfpolicy.LintRule]struct{}{tfpolicy.LintRuleWildcardActionResource: {}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root("policy"),
+				ConfigValue: policy,
+			}
+			var response validator.StringResponse
+
+			newPolicyLintValidator(testPrimary{meta: testCase.Meta}).ValidateString(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := response.Diagnostics.WarningsCount() > 0, testCase.ExpectedWarnings; got != want {
+				t.Errorf("warnings = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
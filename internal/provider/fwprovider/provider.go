@@ -15,7 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/intercept"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -48,6 +50,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"batch_tag_updates": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Update the tags of resources identified by ARN in batches using the Resource Groups Tagging API, falling back to the service API for resources whose tags cannot be updated in a batch.",
+			},
+			"credentials_diagnostics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report which credential sources were checked when credentials cannot be resolved, and log how credentials were resolved. Secret values are never reported.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -151,10 +161,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
+			"api_recorder": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
+				Description: "Configuration block with settings for recording AWS API interactions to, or replaying them from, a cassette file.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cassette": schema.StringAttribute{
+							Required:    true,
+							Description: "Path of the cassette file.",
+						},
+						"mode": schema.StringAttribute{
+							Required:    true,
+							Description: "Either `RECORDING` or `REPLAYING`.",
+						},
+					},
+				},
+			},
+			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -249,12 +274,46 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that have no default resource tags.",
+						},
+						"exclude_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Services, as used in the `endpoints` block, whose resources have no default resource tags.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scoped_tags": schema.ListNestedBlock{
+							Description: "Resource tags to default across the resources of the specified services and resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types to default resource tags across. If not set, all resource types.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services, as used in the `endpoints` block, to default resource tags across. If not set, all services.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the resources.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -278,6 +337,134 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"interceptor": schema.ListNestedBlock{
+				Description: "Configuration block with settings for an interceptor run around every resource operation. Interceptors run in the order configured.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the interceptor.",
+						},
+						"options": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Interceptor-specific options.",
+						},
+					},
+				},
+			},
+			"policy_lint": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for the policy document lint warnings reported during plan.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"disabled_rules": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Policy lint rules to disable across all resources.",
+						},
+					},
+				},
+			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration block with settings for client-side rate limiting of requests to an AWS service or API operation.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of requests sent at once. Defaults to `requests_per_second`.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the API operation to rate limit. If not set, all operations of the service are rate limited.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "Maximum sustained rate of requests.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the service to rate limit, as used in the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "Configuration block with settings for the retries of resource operations, across all services or for a single service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"backoff_base": schema.StringAttribute{
+							Optional:    true,
+							Description: "Wait before the first retry, doubled before each later retry.",
+						},
+						"backoff_cap": schema.StringAttribute{
+							Optional:    true,
+							Description: "Longest wait between retries. Defaults to `10s`.",
+						},
+						"iam_propagation_timeout": schema.StringAttribute{
+							Optional:    true,
+							Description: "Minimum time to retry AWS errors that resources retry by error code or message, such as those caused by IAM eventual consistency.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of attempts before a retryable error is returned.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional AWS error codes that are retried.",
+						},
+						"service": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the service the settings apply to, as used in the `endpoints` block. If not set, the settings apply to all services.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a tag policy that resource tags are validated against across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether tag policy violations are reported as errors or warnings. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"max_tags": schema.MapAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Description: "Maximum number of resource tags, keyed by the name of the service as used in the `endpoints` block.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys required across all resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"allowed_values": schema.SetNestedBlock{
+							Description: "Values allowed for resource tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Values allowed for the resource tag key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -299,6 +486,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 // the Metadata method. All data sources must have unique names.
 func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	var dataSources []func() datasource.DataSource
+	policyLint := newPolicyLintValidator(p.Primary)
 
 	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
 		servicePackageName := sp.ServicePackageName()
@@ -316,18 +504,35 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			metadataResponse := datasource.MetadataResponse{}
+			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
+					ctx = tfresource.NewRetryPolicyContext(ctx, meta.RetryConfig.ForService(servicePackageName))
 				}
 
 				return ctx
 			}
 
+			// The provider adds the `region` argument unless the data source defines its own.
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+			_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+			region := !ok
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner)
+				// Each instance is configured separately, possibly for a different Region.
+				inner := inner
+				if fresh, err := v.Factory(ctx); err == nil {
+					inner = fresh
+				}
+
+				return newWrappedDataSource(bootstrapContext, inner, policyLint, region)
 			})
 		}
 	}
@@ -343,6 +548,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var errs *multierror.Error
 	var resources []func() resource.Resource
+	policyLint := newPolicyLintValidator(p.Primary)
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
 		servicePackageName := sp.ServicePackageName()
@@ -364,19 +570,27 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
+					ctx = tfresource.NewRetryPolicyContext(ctx, meta.RetryConfig.ForService(servicePackageName))
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				commonInterceptor{interceptor: intercept.NewConfiguredInterceptor(typeName)},
+				commonInterceptor{interceptor: intercept.NewAPICallJournalInterceptor(typeName)},
+			}
+
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// The provider adds the `region` argument unless the resource defines its own.
+			_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+			region := !ok
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -396,11 +610,17 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					continue
 				}
 
-				interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
+				interceptors = append(interceptors, newTagsInterceptor(v.Tags))
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				// Each instance is configured separately, possibly for a different Region.
+				inner := inner
+				if fresh, err := v.Factory(ctx); err == nil {
+					inner = fresh
+				}
+
+				return newWrappedResource(bootstrapContext, inner, interceptors, policyLint, region)
 			})
 		}
 	}
@@ -0,0 +1,289 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const regionAttributeDescription = "Region where the resource is managed. Defaults to the region set in the provider configuration."
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+func regionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(regionRegexp, "must be a Region name"),
+	}
+}
+
+// addResourceRegionAttribute adds the optional `region` argument to a resource schema.
+// The resource is replaced if its Region changes, see wrappedResource.ModifyPlan.
+func addResourceRegionAttribute(s *rschema.Schema) {
+	attributes := make(map[string]rschema.Attribute, len(s.Attributes)+1)
+	for k, v := range s.Attributes {
+		attributes[k] = v
+	}
+	attributes[names.AttrRegion] = rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  regionValidators(),
+		Description: regionAttributeDescription,
+	}
+	s.Attributes = attributes
+}
+
+// addDataSourceRegionAttribute adds the optional `region` argument to a data source schema.
+func addDataSourceRegionAttribute(s *dschema.Schema) {
+	attributes := make(map[string]dschema.Attribute, len(s.Attributes)+1)
+	for k, v := range s.Attributes {
+		attributes[k] = v
+	}
+	attributes[names.AttrRegion] = dschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  regionValidators(),
+		Description: regionAttributeDescription,
+	}
+	s.Attributes = attributes
+}
+
+// regionless converts values between a schema with the `region` attribute added by the provider
+// and the resource's or data source's own schema, which its methods expect.
+type regionless struct {
+	template tfsdk.State // The schema without `region`.
+	typ      tftypes.Type
+}
+
+func newResourceRegionless(ctx context.Context, s rschema.Schema) regionless {
+	return regionless{
+		template: tfsdk.State{Schema: s},
+		typ:      s.Type().TerraformType(ctx),
+	}
+}
+
+func newDataSourceRegionless(ctx context.Context, s dschema.Schema) regionless {
+	return regionless{
+		template: tfsdk.State{Schema: s},
+		typ:      s.Type().TerraformType(ctx),
+	}
+}
+
+// remove returns the value without its `region` attribute.
+func (r regionless) remove(v tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(r.typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(r.typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+	delete(attributes, names.AttrRegion)
+
+	if err := tftypes.ValidateValue(r.typ, attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(r.typ, attributes), nil
+}
+
+// restore returns the value without a `region` attribute converted to the type of outer with the specified `region` attribute.
+func (r regionless) restore(outer, v tftypes.Value, region tftypes.Value) (tftypes.Value, error) {
+	typ := outer.Type()
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+	attributes[names.AttrRegion] = region
+
+	if err := tftypes.ValidateValue(typ, attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+func (r regionless) config(v tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	raw, err := r.remove(v.Raw)
+	if err != nil {
+		diags.AddError("removing region from configuration", err.Error())
+	}
+
+	return tfsdk.Config{Schema: r.template.Schema, Raw: raw}
+}
+
+func (r regionless) plan(v tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	raw, err := r.remove(v.Raw)
+	if err != nil {
+		diags.AddError("removing region from plan", err.Error())
+	}
+
+	return tfsdk.Plan{Schema: r.template.Schema, Raw: raw}
+}
+
+func (r regionless) state(v tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	raw, err := r.remove(v.Raw)
+	if err != nil {
+		diags.AddError("removing region from state", err.Error())
+	}
+
+	return tfsdk.State{Schema: r.template.Schema, Raw: raw}
+}
+
+func (r regionless) restorePlan(outer *tfsdk.Plan, v tfsdk.Plan, region tftypes.Value, diags *diag.Diagnostics) {
+	raw, err := r.restore(outer.Raw, v.Raw, region)
+	if err != nil {
+		diags.AddError("adding region to plan", err.Error())
+		return
+	}
+
+	outer.Raw = raw
+}
+
+func (r regionless) restoreState(outer *tfsdk.State, v tfsdk.State, region tftypes.Value, diags *diag.Diagnostics) {
+	raw, err := r.restore(outer.Raw, v.Raw, region)
+	if err != nil {
+		diags.AddError("adding region to state", err.Error())
+		return
+	}
+
+	outer.Raw = raw
+}
+
+// regionValue returns the value of the `region` attribute of an object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if !v.IsNull() && v.IsKnown() {
+		var attributes map[string]tftypes.Value
+		if err := v.As(&attributes); err == nil {
+			if v, ok := attributes[names.AttrRegion]; ok {
+				return v
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionString returns the Region in a known `region` attribute value, or an empty string.
+func regionString(v tftypes.Value) string {
+	var region string
+
+	if v.IsKnown() && !v.IsNull() {
+		_ = v.As(&region)
+	}
+
+	return region
+}
+
+// metaRegion returns the Region of provider Meta as a `region` attribute value.
+func metaRegion(meta *conns.AWSClient) tftypes.Value {
+	if meta == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.Region)
+}
+
+// forRegion returns the provider Meta for the Region in a `region` attribute value and a Context enhanced with it.
+// An empty Region is the provider's Region.
+func forRegion(ctx context.Context, meta *conns.AWSClient, region string) (context.Context, *conns.AWSClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if meta == nil {
+		return ctx, meta, diags
+	}
+
+	regional, sdkDiags := meta.ForRegion(ctx, region)
+	if err := sdkdiag.DiagnosticsError(sdkDiags); err != nil {
+		diags.AddError(fmt.Sprintf("configuring provider for Region (%s)", region), err.Error())
+		return ctx, nil, diags
+	}
+
+	return conns.NewRegionalClientContext(ctx, regional), regional, diags
+}
+
+// importRegionSeparator separates an imported resource's ID from the Region that it is imported from.
+const importRegionSeparator = "@"
+
+// parseImportID splits the ID passed to `terraform import` into the resource ID and an optional Region suffix, e.g. `sgr-12345678@eu-west-1`.
+// An ID whose suffix isn't a Region name is returned unchanged.
+func parseImportID(id string) (string, string) {
+	i := strings.LastIndex(id, importRegionSeparator)
+	if i < 0 {
+		return id, ""
+	}
+
+	if region := id[i+len(importRegionSeparator):]; regionRegexp.MatchString(region) {
+		return id[:i], region
+	}
+
+	return id, ""
+}
+
+// modifyRegionPlan plans the Region that a resource is managed in.
+// An unconfigured `region` defaults to the provider's Region and a resource whose state has no `region`,
+// i.e. one created before the attribute was added, is in the provider's Region.
+// The resource is only replaced if the Region actually changes.
+func modifyRegionPlan(ctx context.Context, config tfsdk.Config, state tfsdk.State, plan *tfsdk.Plan, meta *conns.AWSClient, requiresReplace *path.Paths) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Nothing to do on resource Delete or before the provider is configured.
+	if plan.Raw.IsNull() || meta == nil {
+		return diags
+	}
+
+	configured := regionValue(config.Raw)
+	if !configured.IsKnown() {
+		if !state.Raw.IsNull() {
+			*requiresReplace = append(*requiresReplace, path.Root(names.AttrRegion))
+		}
+
+		return diags
+	}
+
+	region := meta.Region
+	if !configured.IsNull() {
+		region = regionString(configured)
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	old := regionString(regionValue(state.Raw))
+	if old == "" {
+		old = meta.Region
+	}
+
+	if old != region {
+		*requiresReplace = append(*requiresReplace, path.Root(names.AttrRegion))
+	}
+
+	return diags
+}
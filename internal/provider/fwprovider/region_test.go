@@ -0,0 +1,94 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRegionless(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	regionless := newResourceRegionless(ctx, s)
+
+	addResourceRegionAttribute(&s)
+	outerType := s.Type().TerraformType(ctx)
+
+	if _, ok := s.Attributes[names.AttrRegion]; !ok {
+		t.Fatalf("no %s attribute added", names.AttrRegion)
+	}
+
+	outer := tftypes.NewValue(outerType, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "test-id"),
+		"name":           tftypes.NewValue(tftypes.String, "test"),
+		names.AttrRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+	})
+
+	if got, want := regionString(regionValue(outer)), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("region = %q, want %q", got, want)
+	}
+
+	inner, err := regionless.remove(outer)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := inner.Type(), regionless.typ; !got.Equal(want) {
+		t.Errorf("type = %s, want %s", got, want)
+	}
+
+	restored, err := regionless.restore(outer, inner, tftypes.NewValue(tftypes.String, "eu-west-1")) //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !restored.Equal(outer) {
+		t.Errorf("restored = %s, want %s", restored, outer)
+	}
+
+	null, err := regionless.restore(tftypes.NewValue(outerType, nil), tftypes.NewValue(regionless.typ, nil), tftypes.NewValue(tftypes.String, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !null.IsNull() || !null.Type().Equal(outerType) {
+		t.Errorf("restored = %s, want null %s", null, outerType)
+	}
+}
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string][2]string{
+		"sgr-12345678":           {"sgr-12345678", ""},
+		"sgr-12345678@us-east-1": {"sgr-12345678", "us-east-1"}, //lintignore:AWSAT003
+		"a@b@ap-southeast-2":     {"a@b", "ap-southeast-2"},     //lintignore:AWSAT003
+		"user@example.com":       {"user@example.com", ""},
+	}
+
+	for id, expected := range testCases {
+		gotID, gotRegion := parseImportID(id)
+
+		if gotID != expected[0] || gotRegion != expected[1] {
+			t.Errorf("parseImportID(%q) = (%q, %q), want (%q, %q)", id, gotID, gotRegion, expected[0], expected[1])
+		}
+	}
+}
@@ -2,24 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/intercept"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/types"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
-// no further interceptors in the chain are run and neither is the schema's method.
+// no further interceptors in the chain are run and neither is the schema's method,
+// but the OnError and Finally interceptors earlier in the chain are run.
 // In other cases all interceptors in the chain are run.
 type interceptor interface {
 	run(context.Context, *schema.ResourceData, any, when, why, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -40,26 +38,26 @@ type interceptorItem struct {
 
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
-type when uint16
+type when = intercept.When
 
 const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Before  = intercept.Before  // Interceptor is invoked before call to method in schema
+	After   = intercept.After   // Interceptor is invoked after successful call to method in schema
+	OnError = intercept.OnError // Interceptor is invoked after unsuccessful call to method in schema
+	Finally = intercept.Finally // Interceptor is invoked after After or OnError
 )
 
 // why represents the CRUD operation(s) that an interceptor is run.
 // Multiple values can be ORed together.
-type why uint16
+type why = intercept.Why
 
 const (
-	Create why = 1 << iota // Interceptor is invoked for a Create call
-	Read                   // Interceptor is invoked for a Read call
-	Update                 // Interceptor is invoked for an Update call
-	Delete                 // Interceptor is invoked for a Delete call
+	Create = intercept.Create // Interceptor is invoked for a Create call
+	Read   = intercept.Read   // Interceptor is invoked for a Read call
+	Update = intercept.Update // Interceptor is invoked for an Update call
+	Delete = intercept.Delete // Interceptor is invoked for a Delete call
 
-	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
+	AllOps = intercept.AllOps // Interceptor is invoked for all calls
 )
 
 type interceptorItems []interceptorItem
@@ -78,22 +76,32 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		ctx = bootstrapContext(ctx, meta)
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
+		shortCircuited := false
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			if v.when&when != 0 {
 				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 
 				// Short circuit if any Before interceptor errors.
+				// Interceptors earlier in the chain still see the operation fail.
 				if diags.HasError() {
-					return diags
+					forward, shortCircuited = forward[:i], true
+					break
+				}
+
+				// The provider Meta for the resource's Region is used by the handler and all later interceptors.
+				if v, ok := conns.RegionalClientFromContext(ctx); ok {
+					meta = v
 				}
 			}
 		}
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		if !shortCircuited {
+			diags = f(ctx, d, meta)
+		}
 
 		if diags.HasError() {
 			when = OnError
@@ -136,6 +144,8 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// region is whether the resource's `region` attribute was added by the provider.
+	region bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -158,14 +168,42 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if v, ok := meta.(*conns.AWSClient); ok && r.region {
+			var err error
+
+			ctx, meta, err = importRegion(ctx, d, v)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
 
+// CustomizeDiff wraps the schema's CustomizeDiff function, which may be nil.
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if v, ok := meta.(*conns.AWSClient); ok && r.region {
+			region, err := customizeRegionDiff(ctx, d, v)
+			if err != nil {
+				return err
+			}
+
+			regional, diags := v.ForRegion(ctx, region)
+			if err := sdkdiag.DiagnosticsError(diags); err != nil {
+				return err
+			}
+
+			ctx, meta = conns.NewRegionalClientContext(ctx, regional), regional
+		}
+
+		if f == nil {
+			return nil
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -178,166 +216,75 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
-// tagsInterceptor implements transparent tagging.
-type tagsInterceptor struct {
-	tags *types.ServicePackageResourceTags
+// sdkResourceData adapts Plugin SDK v2 ResourceData to the common interceptor ResourceData.
+type sdkResourceData struct {
+	d *schema.ResourceData
 }
 
-func (r tagsInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	if r.tags == nil {
-		return ctx, diags
-	}
-
-	inContext, ok := conns.FromContext(ctx)
-	if !ok {
-		return ctx, diags
-	}
+func (d sdkResourceData) Id() string {
+	return d.d.Id()
+}
 
-	sp, ok := meta.(*conns.AWSClient).ServicePackages[inContext.ServicePackageName]
-	if !ok {
-		return ctx, diags
-	}
+func (d sdkResourceData) Exists() bool {
+	return d.d.Id() != ""
+}
 
-	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
-	if err != nil {
-		serviceName = "<service>"
-	}
+// ReadAfterWrite returns true as Plugin SDK v2 resources' C & U handlers are assumed to tail call the R handler.
+func (d sdkResourceData) ReadAfterWrite() bool {
+	return true
+}
 
-	resourceName := inContext.ResourceName
-	if resourceName == "" {
-		resourceName = "<thing>"
+func (d sdkResourceData) GetString(_ context.Context, name string) (string, error) {
+	if name == "id" {
+		return d.d.Id(), nil
 	}
 
-	tagsInContext, ok := tftags.FromContext(ctx)
+	v, ok := d.d.Get(name).(string)
 	if !ok {
-		return ctx, diags
+		return "", fmt.Errorf("attribute %s is not a string", name)
 	}
 
-	switch when {
-	case Before:
-		switch why {
-		case Create, Update:
-			// Merge the resource's configured tags with any provider configured default_tags.
-			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
-			// Remove system tags.
-			tags = tags.IgnoreSystem(inContext.ServicePackageName)
+	return v, nil
+}
 
-			tagsInContext.TagsIn = types.Some(tags)
+func (d sdkResourceData) GetTags(ctx context.Context) (tftags.KeyValueTags, error) {
+	return tftags.New(ctx, d.d.Get(names.AttrTags).(map[string]interface{})), nil
+}
 
-			if why == Create {
-				break
-			}
+func (d sdkResourceData) GetTagsAllChange(_ context.Context) (any, any, bool, error) {
+	o, n := d.d.GetChange(names.AttrTagsAll)
 
-			if d.HasChange(names.AttrTagsAll) {
-				if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
-					var identifier string
-					if identifierAttribute == "id" {
-						identifier = d.Id()
-					} else {
-						identifier = d.Get(identifierAttribute).(string)
-					}
-					o, n := d.GetChange(names.AttrTagsAll)
-
-					// If the service package has a generic resource update tags methods, call it.
-					var err error
-
-					if v, ok := sp.(interface {
-						UpdateTags(context.Context, any, string, any, any) error
-					}); ok {
-						err = v.UpdateTags(ctx, meta, identifier, o, n)
-					} else if v, ok := sp.(interface {
-						UpdateTags(context.Context, any, string, string, any, any) error
-					}); ok && r.tags.ResourceType != "" {
-						err = v.UpdateTags(ctx, meta, identifier, r.tags.ResourceType, o, n)
-					}
-
-					if verify.ErrorISOUnsupported(meta.(*conns.AWSClient).Partition, err) {
-						// ISO partitions may not support tagging, giving error
-						tflog.Warn(ctx, "failed updating tags for resource", map[string]interface{}{
-							r.tags.IdentifierAttribute: identifier,
-							"error":                    err.Error(),
-						})
-
-						return ctx, diags
-					}
-
-					if err != nil {
-						return ctx, sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
-					}
-				}
-				// TODO If the only change was to tags it would be nice to not call the resource's U handler.
-			}
-		}
-	case After:
-		// Set tags and tags_all in state after CRU.
-		// C & U handlers are assumed to tail call the R handler.
-		switch why {
-		case Read:
-			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
-			if d.Id() == "" {
-				return ctx, diags
-			}
+	return o, n, d.d.HasChange(names.AttrTagsAll), nil
+}
 
-			fallthrough
-		case Create, Update:
-			// If the R handler didn't set tags, try and read them from the service API.
-			if tagsInContext.TagsOut.IsNone() {
-				if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
-					var identifier string
-					if identifierAttribute == "id" {
-						identifier = d.Id()
-					} else {
-						identifier = d.Get(identifierAttribute).(string)
-					}
-
-					// If the service package has a generic resource list tags methods, call it.
-					var err error
-
-					if v, ok := sp.(interface {
-						ListTags(context.Context, any, string) error
-					}); ok {
-						err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
-					} else if v, ok := sp.(interface {
-						ListTags(context.Context, any, string, string) error
-					}); ok && r.tags.ResourceType != "" {
-						err = v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
-					}
-
-					if verify.ErrorISOUnsupported(meta.(*conns.AWSClient).Partition, err) {
-						// ISO partitions may not support tagging, giving error
-						tflog.Warn(ctx, "failed listing tags for resource", map[string]interface{}{
-							r.tags.IdentifierAttribute: d.Id(),
-							"error":                    err.Error(),
-						})
-						return ctx, diags
-					}
-
-					if inContext.ServicePackageName == names.DynamoDB && err != nil {
-						// When a DynamoDB Table is `ARCHIVED`, ListTags returns `ResourceNotFoundException`.
-						if tfresource.NotFound(err) || tfawserr.ErrMessageContains(err, "UnknownOperationException", "Tagging is not currently supported in DynamoDB Local.") {
-							err = nil
-						}
-					}
-
-					if err != nil {
-						return ctx, sdkdiag.AppendErrorf(diags, "listing tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
-					}
-				}
-			}
+func (d sdkResourceData) SetTags(_ context.Context, tags map[string]string) error {
+	return d.d.Set(names.AttrTags, tags)
+}
 
-			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+func (d sdkResourceData) SetTagsAll(_ context.Context, tags map[string]string) error {
+	return d.d.Set(names.AttrTagsAll, tags)
+}
 
-			// The resource's configured tags do not include any provider configured default_tags.
-			if err := d.Set(names.AttrTags, tags.RemoveDefaultConfig(tagsInContext.DefaultConfig).Map()); err != nil {
-				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
-			}
+// commonInterceptor runs a common resource interceptor for a Plugin SDK v2 resource.
+type commonInterceptor struct {
+	interceptor intercept.ResourceInterceptor
+}
 
-			// Computed tags_all do.
-			if err := d.Set(names.AttrTagsAll, tags.Map()); err != nil {
-				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTagsAll, err)
-			}
+func (r commonInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	client, _ := meta.(*conns.AWSClient)
+
+	ctx, newDiags := r.interceptor.Run(ctx, sdkResourceData{d: d}, client, when, why, sdkdiag.DiagnosticsError(diags))
+
+	for _, v := range newDiags {
+		severity := diag.Error
+		if v.Severity == intercept.SeverityWarning {
+			severity = diag.Warning
 		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  v.String(),
+		})
 	}
 
 	return ctx, diags
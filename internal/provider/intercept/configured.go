@@ -0,0 +1,60 @@
+package intercept
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+)
+
+// configuredInterceptor runs any interceptors configured in the provider.
+type configuredInterceptor struct {
+	typeName string
+}
+
+// NewConfiguredInterceptor returns an interceptor that runs any interceptors configured in the provider around a resource's CRUD handlers.
+func NewConfiguredInterceptor(typeName string) ResourceInterceptor {
+	return configuredInterceptor{typeName: typeName}
+}
+
+func (r configuredInterceptor) Run(ctx context.Context, d ResourceData, meta *conns.AWSClient, when When, why Why, errs error) (context.Context, Diagnostics) {
+	var diags Diagnostics
+
+	if meta == nil || len(meta.Interceptors) == 0 {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		invocation := &interceptors.Invocation{
+			TypeName:  r.typeName,
+			Operation: interceptors.Operation(why.String()),
+			ID:        d.Id(),
+			Start:     time.Now(),
+		}
+		if inContext, ok := conns.FromContext(ctx); ok {
+			invocation.ResourceName = inContext.ResourceName
+			invocation.ServicePackageName = inContext.ServicePackageName
+		}
+		ctx = interceptors.NewContext(ctx, invocation)
+
+		if err := meta.Interceptors.Before(ctx, invocation); err != nil {
+			diags.AddError(err.Error(), "")
+		}
+	case After, OnError:
+		invocation, ok := interceptors.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		// The ID is cleared when a resource is deleted or not found.
+		if id := d.Id(); id != "" {
+			invocation.ID = id
+		}
+
+		meta.Interceptors.After(ctx, invocation, errs)
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,80 @@
+package intercept
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+)
+
+func TestConfiguredInterceptor(t *testing.T) {
+	t.Parallel()
+
+	deny, err := interceptors.New(interceptors.DenyResourceTypesName, map[string]string{"resource_types": "aws_test_thing"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name        string
+		TypeName    string
+		Why         Why
+		ExpectError bool
+	}{
+		{
+			Name:        "denied create",
+			TypeName:    "aws_test_thing",
+			Why:         Create,
+			ExpectError: true,
+		},
+		{
+			Name:     "allowed read",
+			TypeName: "aws_test_thing",
+			Why:      Read,
+		},
+		{
+			Name:     "other type",
+			TypeName: "aws_test_other",
+			Why:      Delete,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Thing")
+			meta := &conns.AWSClient{Interceptors: interceptors.Chain{deny}}
+			d := &testResourceData{id: "thing-1"}
+			interceptor := NewConfiguredInterceptor(testCase.TypeName)
+
+			ctx, diags := interceptor.Run(ctx, d, meta, Before, testCase.Why, nil)
+
+			if got, want := diags.HasError(), testCase.ExpectError; got != want {
+				t.Fatalf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			invocation, ok := interceptors.FromContext(ctx)
+
+			if !ok {
+				t.Fatal("expected invocation in Context")
+			}
+
+			if got, want := invocation.ID, "thing-1"; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+
+			if _, diags := interceptor.Run(ctx, d, meta, After, testCase.Why, nil); len(diags) > 0 {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
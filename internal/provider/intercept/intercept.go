@@ -0,0 +1,128 @@
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// When represents the point in the CRUD request lifecycle that an interceptor is run.
+// Multiple values can be ORed together.
+type When uint16
+
+const (
+	Before  When = 1 << iota // Interceptor is invoked before call to method in schema
+	After                    // Interceptor is invoked after successful call to method in schema
+	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
+	Finally                  // Interceptor is invoked after After or OnError
+)
+
+// Why represents the CRUD operation(s) that an interceptor is run.
+// Multiple values can be ORed together.
+type Why uint16
+
+const (
+	Create Why = 1 << iota // Interceptor is invoked for a Create call
+	Read                   // Interceptor is invoked for a Read call
+	Update                 // Interceptor is invoked for an Update call
+	Delete                 // Interceptor is invoked for a Delete call
+
+	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
+)
+
+// String returns the name of a single CRUD operation.
+func (why Why) String() string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return fmt.Sprintf("Why(%d)", uint16(why))
+	}
+}
+
+// ResourceData is an adapter over a resource's plan and state.
+// It has implementations for Plugin SDK v2 `*schema.ResourceData` and for Plugin Framework plan and state.
+type ResourceData interface {
+	// Id returns the resource's ID, or an empty string if the resource has no ID.
+	Id() string
+	// Exists returns whether the resource exists in state after the CRUD handler has run.
+	Exists() bool
+	// ReadAfterWrite returns whether the resource's Create and Update handlers tail call its Read handler.
+	ReadAfterWrite() bool
+	// GetString returns the value of the specified string attribute.
+	GetString(ctx context.Context, name string) (string, error)
+	// GetTags returns the resource's configured tags.
+	GetTags(ctx context.Context) (tftags.KeyValueTags, error)
+	// GetTagsAllChange returns the old and new values of the resource's tags_all attribute and whether they differ.
+	GetTagsAllChange(ctx context.Context) (any, any, bool, error)
+	// SetTags sets the resource's tags attribute.
+	SetTags(ctx context.Context, tags map[string]string) error
+	// SetTagsAll sets the resource's tags_all attribute.
+	SetTagsAll(ctx context.Context, tags map[string]string) error
+}
+
+// A ResourceInterceptor is functionality invoked during a resource's CRUD request lifecycle.
+// It is written once and runs for both Plugin SDK v2 and Plugin Framework resources.
+// errs contains any errors reported so far, e.g. by the resource's CRUD handler.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+type ResourceInterceptor interface {
+	Run(ctx context.Context, d ResourceData, meta *conns.AWSClient, when When, why Why, errs error) (context.Context, Diagnostics)
+}
+
+// Severity is the severity of a Diagnostic.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+// Diagnostic is an error or warning reported by an interceptor.
+// It is converted to a Plugin SDK v2 or Plugin Framework diagnostic.
+type Diagnostic struct {
+	Severity Severity
+	Summary  string
+	Detail   string
+}
+
+// String returns the Diagnostic's summary and any detail.
+func (d Diagnostic) String() string {
+	if d.Detail == "" {
+		return d.Summary
+	}
+
+	return fmt.Sprintf("%s: %s", d.Summary, d.Detail)
+}
+
+// Diagnostics is a list of Diagnostic.
+type Diagnostics []Diagnostic
+
+// AddError appends an error.
+func (diags *Diagnostics) AddError(summary, detail string) {
+	*diags = append(*diags, Diagnostic{Severity: SeverityError, Summary: summary, Detail: detail})
+}
+
+// AddWarning appends a warning.
+func (diags *Diagnostics) AddWarning(summary, detail string) {
+	*diags = append(*diags, Diagnostic{Severity: SeverityWarning, Summary: summary, Detail: detail})
+}
+
+// HasError returns whether any Diagnostic is an error.
+func (diags Diagnostics) HasError() bool {
+	for _, v := range diags {
+		if v.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package intercept
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type journalResourceIDKeyType int
+
+var journalResourceIDKey journalResourceIDKeyType
+
+// apiCallJournalInterceptor records the AWS API calls made by each resource CRUD operation.
+type apiCallJournalInterceptor struct {
+	typeName string
+}
+
+// NewAPICallJournalInterceptor returns an interceptor that records the AWS API calls made by a resource's CRUD handlers.
+// The calls are logged after each operation and, if configured, appended to the API call journal file.
+func NewAPICallJournalInterceptor(typeName string) ResourceInterceptor {
+	return apiCallJournalInterceptor{typeName: typeName}
+}
+
+func (r apiCallJournalInterceptor) Run(ctx context.Context, d ResourceData, meta *conns.AWSClient, when When, why Why, errs error) (context.Context, Diagnostics) {
+	var diags Diagnostics
+
+	switch when {
+	case Before:
+		ctx = conns.NewAPICallJournalContext(ctx)
+		// The ID is cleared when a resource is deleted or not found.
+		ctx = context.WithValue(ctx, journalResourceIDKey, d.Id())
+	case After, OnError:
+		journal, ok := conns.APICallJournalFromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		calls := journal.Calls()
+		if len(calls) == 0 {
+			return ctx, diags
+		}
+
+		id := d.Id()
+		if id == "" {
+			id, _ = ctx.Value(journalResourceIDKey).(string)
+		}
+		fields := map[string]any{
+			"tf_aws.journal.resource_type":      r.typeName,
+			"tf_aws.journal.resource_id":        id,
+			"tf_aws.journal.resource_operation": why.String(),
+			"tf_aws.journal.calls":              calls,
+		}
+		if errs != nil {
+			fields["tf_aws.journal.error"] = errs.Error()
+		}
+		tflog.Debug(ctx, "AWS API call journal", fields)
+
+		if meta == nil || meta.APICallJournalWriter == nil {
+			return ctx, diags
+		}
+
+		records := make([]conns.APICallJournalRecord, len(calls))
+		for i, call := range calls {
+			records[i] = conns.APICallJournalRecord{
+				APICall:           call,
+				TypeName:          r.typeName,
+				ID:                id,
+				ResourceOperation: why.String(),
+			}
+		}
+
+		if err := meta.APICallJournalWriter.Write(records); err != nil {
+			tflog.Warn(ctx, "writing AWS API call journal", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return ctx, diags
+}
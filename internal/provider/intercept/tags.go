@@ -0,0 +1,286 @@
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagsInterceptor implements transparent tagging.
+type tagsInterceptor struct {
+	tags *types.ServicePackageResourceTags
+}
+
+// NewTagsInterceptor returns an interceptor that implements transparent tagging for a resource.
+func NewTagsInterceptor(tags *types.ServicePackageResourceTags) ResourceInterceptor {
+	return tagsInterceptor{tags: tags}
+}
+
+func (r tagsInterceptor) Run(ctx context.Context, d ResourceData, meta *conns.AWSClient, when When, why Why, errs error) (context.Context, Diagnostics) {
+	var diags Diagnostics
+
+	if r.tags == nil || meta == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	sp, ok := meta.ServicePackages[inContext.ServicePackageName]
+	if !ok {
+		return ctx, diags
+	}
+
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Create, Update:
+			configTags, err := d.GetTags(ctx)
+
+			if err != nil {
+				diags.AddError(fmt.Sprintf("reading %s", names.AttrTags), err.Error())
+
+				return ctx, diags
+			}
+
+			// Merge the resource's configured tags with any provider configured default_tags.
+			tags := tagsInContext.DefaultConfig.MergeTags(configTags)
+			// Remove system tags.
+			tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+			// Validate the resource's tags against any provider configured tag_policy.
+			if tagPolicyConfig := meta.TagPolicyConfig; tagPolicyConfig != nil {
+				summary := fmt.Sprintf("tag policy violation for %s %s", serviceName, resourceName)
+
+				for _, violation := range tagPolicyConfig.Validate(inContext.ServicePackageName, tags) {
+					if tagPolicyConfig.Warn() {
+						diags.AddWarning(summary, violation)
+					} else {
+						diags.AddError(summary, violation)
+					}
+				}
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+			}
+
+			tagsInContext.TagsIn = types.Some(tags)
+
+			if why == Create {
+				// If the resource's tags are set in the request that creates the resource, identify it.
+				if r.tags.CreateOperation != "" {
+					tagsInContext.CreateTags = &tftags.CreateTags{
+						Operation: r.tags.CreateOperation,
+						Field:     r.tags.CreateTagsField,
+					}
+				}
+
+				break
+			}
+
+			// Tags that can only be set when the resource is created are never updated in-place.
+			if r.tags.CreateOnly {
+				break
+			}
+
+			o, n, changed, err := d.GetTagsAllChange(ctx)
+
+			if err != nil {
+				diags.AddError(fmt.Sprintf("reading %s", names.AttrTagsAll), err.Error())
+
+				return ctx, diags
+			}
+
+			if !changed {
+				break
+			}
+
+			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
+				identifier, err := d.GetString(ctx, identifierAttribute)
+
+				if err != nil {
+					diags.AddError(fmt.Sprintf("reading %s", identifierAttribute), err.Error())
+
+					return ctx, diags
+				}
+
+				if !batchUpdateTags(ctx, meta, inContext.ServicePackageName, identifier, o, n) {
+					// If the service package has a generic resource update tags methods, call it.
+					if v, ok := sp.(interface {
+						UpdateTags(context.Context, any, string, any, any) error
+					}); ok {
+						err = v.UpdateTags(ctx, meta, identifier, o, n)
+					} else if v, ok := sp.(interface {
+						UpdateTags(context.Context, any, string, string, any, any) error
+					}); ok && r.tags.ResourceType != "" {
+						err = v.UpdateTags(ctx, meta, identifier, r.tags.ResourceType, o, n)
+					}
+				}
+
+				if verify.ErrorISOUnsupported(meta.Partition, err) {
+					// ISO partitions may not support tagging, giving error
+					tflog.Warn(ctx, "failed updating tags for resource", map[string]interface{}{
+						identifierAttribute: identifier,
+						"error":             err.Error(),
+					})
+
+					return ctx, diags
+				}
+
+				if err != nil {
+					diags.AddError(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+
+					return ctx, diags
+				}
+			}
+			// TODO If the only change was to tags it would be nice to not call the resource's U handler.
+		}
+	case After:
+		// Set tags and tags_all in state after CRU.
+		switch why {
+		case Create, Update:
+			if !d.ReadAfterWrite() {
+				// The C & U handlers don't call the R handler.
+				// Set values for unknowns after Create; after Update the planned values are used.
+				if why == Create {
+					// Remove any provider configured ignore_tags and system tags from those passed to the service API.
+					// Computed tags_all include any provider configured default_tags.
+					if err := d.SetTagsAll(ctx, tagsInContext.TagsIn.MustUnwrap().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).Map()); err != nil {
+						diags.AddError(fmt.Sprintf("setting %s", names.AttrTagsAll), err.Error())
+					}
+				}
+
+				return ctx, diags
+			}
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if !d.Exists() {
+				return ctx, diags
+			}
+		default:
+			return ctx, diags
+		}
+
+		// If the R handler didn't set tags, try and read them from the service API.
+		if tagsInContext.TagsOut.IsNone() {
+			if r.tags.CreateOnly && r.tags.IdentifierAttribute == "" {
+				// The resource's tags cannot be read from the service API.
+				// After Create or Update the tags are those configured, otherwise they are unchanged.
+				if why == Read {
+					return ctx, diags
+				}
+
+				tagsInContext.TagsOut = tagsInContext.TagsIn
+			} else if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
+				identifier, err := d.GetString(ctx, identifierAttribute)
+
+				if err != nil {
+					diags.AddError(fmt.Sprintf("reading %s", identifierAttribute), err.Error())
+
+					return ctx, diags
+				}
+
+				// If the service package has a generic resource list tags methods, call it.
+				if v, ok := sp.(interface {
+					ListTags(context.Context, any, string) error
+				}); ok {
+					err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
+				} else if v, ok := sp.(interface {
+					ListTags(context.Context, any, string, string) error
+				}); ok && r.tags.ResourceType != "" {
+					err = v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
+				}
+
+				if verify.ErrorISOUnsupported(meta.Partition, err) {
+					// ISO partitions may not support tagging, giving error
+					tflog.Warn(ctx, "failed listing tags for resource", map[string]interface{}{
+						identifierAttribute: identifier,
+						"error":             err.Error(),
+					})
+
+					return ctx, diags
+				}
+
+				if inContext.ServicePackageName == names.DynamoDB && err != nil {
+					// When a DynamoDB Table is `ARCHIVED`, ListTags returns `ResourceNotFoundException`.
+					if tfresource.NotFound(err) || tfawserr.ErrMessageContains(err, "UnknownOperationException", "Tagging is not currently supported in DynamoDB Local.") {
+						err = nil
+					}
+				}
+
+				if err != nil {
+					diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+
+					return ctx, diags
+				}
+			}
+		}
+
+		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
+		tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+
+		// The resource's configured tags do not include any provider configured default_tags.
+		if err := d.SetTags(ctx, tags.RemoveDefaultConfig(tagsInContext.DefaultConfig).Map()); err != nil {
+			diags.AddError(fmt.Sprintf("setting %s", names.AttrTags), err.Error())
+
+			return ctx, diags
+		}
+
+		// Computed tags_all do.
+		if err := d.SetTagsAll(ctx, tags.Map()); err != nil {
+			diags.AddError(fmt.Sprintf("setting %s", names.AttrTagsAll), err.Error())
+
+			return ctx, diags
+		}
+	}
+
+	return ctx, diags
+}
+
+// batchUpdateTags updates a resource's tags in a batch with other resources' tag updates, if tag update batching is enabled.
+// Only resources identified by ARN are batched.
+// It returns false if the resource's tags must be updated using the service API.
+func batchUpdateTags(ctx context.Context, meta *conns.AWSClient, servicePackageName, identifier string, oldTags, newTags any) bool {
+	if meta.TagBatcher == nil || !arn.IsARN(identifier) {
+		return false
+	}
+
+	if err := meta.TagBatcher.Update(ctx, meta.Region, servicePackageName, identifier, oldTags, newTags, tfresourcegroupstaggingapi.NewBulkTagger(meta.ResourceGroupsTaggingAPIConn())); err != nil {
+		tflog.Debug(ctx, "batch updating tags for resource, updating individually", map[string]interface{}{
+			"identifier": identifier,
+			"error":      err.Error(),
+		})
+
+		return false
+	}
+
+	return true
+}
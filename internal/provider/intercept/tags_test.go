@@ -0,0 +1,366 @@
+package intercept
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// testResourceData is a ResourceData for testing.
+type testResourceData struct {
+	id             string
+	exists         bool
+	readAfterWrite bool
+	strings        map[string]string
+	tags           map[string]string
+	oldTagsAll     map[string]string
+	newTagsAll     map[string]string
+
+	setTags    map[string]string
+	setTagsAll map[string]string
+}
+
+func (d *testResourceData) Id() string {
+	return d.id
+}
+
+func (d *testResourceData) Exists() bool {
+	return d.exists
+}
+
+func (d *testResourceData) ReadAfterWrite() bool {
+	return d.readAfterWrite
+}
+
+func (d *testResourceData) GetString(ctx context.Context, name string) (string, error) {
+	v, ok := d.strings[name]
+	if !ok {
+		return "", errors.New("no such attribute")
+	}
+
+	return v, nil
+}
+
+func (d *testResourceData) GetTags(ctx context.Context) (tftags.KeyValueTags, error) {
+	return tftags.New(ctx, d.tags), nil
+}
+
+func (d *testResourceData) GetTagsAllChange(ctx context.Context) (any, any, bool, error) {
+	return d.oldTagsAll, d.newTagsAll, !cmp.Equal(d.oldTagsAll, d.newTagsAll), nil
+}
+
+func (d *testResourceData) SetTags(ctx context.Context, tags map[string]string) error {
+	d.setTags = tags
+	return nil
+}
+
+func (d *testResourceData) SetTagsAll(ctx context.Context, tags map[string]string) error {
+	d.setTagsAll = tags
+	return nil
+}
+
+// testServicePackage is a service package with generic tagging methods for testing.
+type testServicePackage struct {
+	listTags    map[string]string
+	listTagsErr error
+	updated     []string
+}
+
+func (p *testServicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	if p.listTagsErr != nil {
+		return p.listTagsErr
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tftags.New(ctx, p.listTags))
+	}
+
+	return nil
+}
+
+func (p *testServicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
+	p.updated = append(p.updated, identifier)
+
+	return nil
+}
+
+func TestTagsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Tags          *types.ServicePackageResourceTags
+		When          When
+		Why           Why
+		DefaultTags   map[string]string
+		PolicyConfig  *tftags.PolicyConfig
+		ResourceData  testResourceData
+		TagsOut       map[string]string
+		ListTags      map[string]string
+		ListTagsErr   error
+		ExpectError   bool
+		ExpectWarning bool
+		ExpectTagsIn  map[string]string
+		ExpectCreate  *tftags.CreateTags
+		ExpectUpdated []string
+		ExpectSetTags map[string]string
+		ExpectTagsAll map[string]string
+	}{
+		{
+			Name:         "create before",
+			Tags:         &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:         Before,
+			Why:          Create,
+			DefaultTags:  map[string]string{"dk": "dv"},
+			ResourceData: testResourceData{tags: map[string]string{"k1": "v1"}},
+			ExpectTagsIn: map[string]string{"dk": "dv", "k1": "v1"},
+		},
+		{
+			Name:         "create before create tags",
+			Tags:         &types.ServicePackageResourceTags{CreateOperation: "CreateThing", CreateTagsField: "Tags"},
+			When:         Before,
+			Why:          Create,
+			ResourceData: testResourceData{tags: map[string]string{"k1": "v1"}},
+			ExpectTagsIn: map[string]string{"k1": "v1"},
+			ExpectCreate: &tftags.CreateTags{Operation: "CreateThing", Field: "Tags"},
+		},
+		{
+			Name:         "create before policy error",
+			Tags:         &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:         Before,
+			Why:          Create,
+			PolicyConfig: &tftags.PolicyConfig{RequiredKeys: []string{"Owner"}},
+			ResourceData: testResourceData{tags: map[string]string{"k1": "v1"}},
+			ExpectError:  true,
+		},
+		{
+			Name:          "create before policy warning",
+			Tags:          &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:          Before,
+			Why:           Create,
+			PolicyConfig:  &tftags.PolicyConfig{Enforcement: tftags.PolicyEnforcementWarn, RequiredKeys: []string{"Owner"}},
+			ResourceData:  testResourceData{tags: map[string]string{"k1": "v1"}},
+			ExpectWarning: true,
+			ExpectTagsIn:  map[string]string{"k1": "v1"},
+		},
+		{
+			Name: "update before",
+			Tags: &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When: Before,
+			Why:  Update,
+			ResourceData: testResourceData{
+				strings:    map[string]string{"arn": "arn:aws:test:us-west-2:123456789012:thing/1"},
+				tags:       map[string]string{"k1": "v2"},
+				oldTagsAll: map[string]string{"k1": "v1"},
+				newTagsAll: map[string]string{"k1": "v2"},
+			},
+			ExpectTagsIn:  map[string]string{"k1": "v2"},
+			ExpectUpdated: []string{"arn:aws:test:us-west-2:123456789012:thing/1"},
+		},
+		{
+			Name: "update before unchanged",
+			Tags: &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When: Before,
+			Why:  Update,
+			ResourceData: testResourceData{
+				strings:    map[string]string{"arn": "arn:aws:test:us-west-2:123456789012:thing/1"},
+				tags:       map[string]string{"k1": "v1"},
+				oldTagsAll: map[string]string{"k1": "v1"},
+				newTagsAll: map[string]string{"k1": "v1"},
+			},
+			ExpectTagsIn: map[string]string{"k1": "v1"},
+		},
+		{
+			Name: "update before create only",
+			Tags: &types.ServicePackageResourceTags{IdentifierAttribute: "arn", CreateOnly: true},
+			When: Before,
+			Why:  Update,
+			ResourceData: testResourceData{
+				strings:    map[string]string{"arn": "arn:aws:test:us-west-2:123456789012:thing/1"},
+				tags:       map[string]string{"k1": "v2"},
+				oldTagsAll: map[string]string{"k1": "v1"},
+				newTagsAll: map[string]string{"k1": "v2"},
+			},
+			ExpectTagsIn: map[string]string{"k1": "v2"},
+		},
+		{
+			Name:          "read after list tags",
+			Tags:          &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:          After,
+			Why:           Read,
+			DefaultTags:   map[string]string{"dk": "dv"},
+			ResourceData:  testResourceData{exists: true, strings: map[string]string{"arn": "arn:aws:test:us-west-2:123456789012:thing/1"}},
+			ListTags:      map[string]string{"dk": "dv", "k1": "v1", "aws:cloudformation:stack-name": "s"},
+			ExpectSetTags: map[string]string{"k1": "v1"},
+			ExpectTagsAll: map[string]string{"dk": "dv", "k1": "v1"},
+		},
+		{
+			Name:          "read after tags out",
+			Tags:          &types.ServicePackageResourceTags{},
+			When:          After,
+			Why:           Read,
+			ResourceData:  testResourceData{exists: true},
+			TagsOut:       map[string]string{"k1": "v1"},
+			ExpectSetTags: map[string]string{"k1": "v1"},
+			ExpectTagsAll: map[string]string{"k1": "v1"},
+		},
+		{
+			Name:         "read after list tags error",
+			Tags:         &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:         After,
+			Why:          Read,
+			ResourceData: testResourceData{exists: true, strings: map[string]string{"arn": "arn:aws:test:us-west-2:123456789012:thing/1"}},
+			ListTagsErr:  errors.New("failed"),
+			ExpectError:  true,
+		},
+		{
+			Name:         "read after not found",
+			Tags:         &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:         After,
+			Why:          Read,
+			ResourceData: testResourceData{strings: map[string]string{"arn": "arn:aws:test:us-west-2:123456789012:thing/1"}},
+			ListTags:     map[string]string{"k1": "v1"},
+		},
+		{
+			Name:         "read after create only",
+			Tags:         &types.ServicePackageResourceTags{CreateOnly: true},
+			When:         After,
+			Why:          Read,
+			ResourceData: testResourceData{exists: true},
+		},
+		{
+			Name:          "create after create only",
+			Tags:          &types.ServicePackageResourceTags{CreateOnly: true},
+			When:          After,
+			Why:           Create,
+			DefaultTags:   map[string]string{"dk": "dv"},
+			ResourceData:  testResourceData{exists: true, readAfterWrite: true, tags: map[string]string{"k1": "v1"}},
+			ExpectTagsIn:  map[string]string{"dk": "dv", "k1": "v1"},
+			ExpectSetTags: map[string]string{"k1": "v1"},
+			ExpectTagsAll: map[string]string{"dk": "dv", "k1": "v1"},
+		},
+		{
+			Name:          "create after no read after write",
+			Tags:          &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:          After,
+			Why:           Create,
+			DefaultTags:   map[string]string{"dk": "dv"},
+			ResourceData:  testResourceData{exists: true, tags: map[string]string{"k1": "v1"}},
+			ExpectTagsIn:  map[string]string{"dk": "dv", "k1": "v1"},
+			ExpectTagsAll: map[string]string{"dk": "dv", "k1": "v1"},
+		},
+		{
+			Name:         "update after no read after write",
+			Tags:         &types.ServicePackageResourceTags{IdentifierAttribute: "arn"},
+			When:         After,
+			Why:          Update,
+			ResourceData: testResourceData{exists: true, tags: map[string]string{"k1": "v1"}},
+			ExpectTagsIn: map[string]string{"k1": "v1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &testServicePackage{listTags: testCase.ListTags, listTagsErr: testCase.ListTagsErr}
+			meta := &conns.AWSClient{
+				Partition:       "aws",
+				ServicePackages: map[string]conns.ServicePackage{"test": sp},
+				TagPolicyConfig: testCase.PolicyConfig,
+			}
+			var defaultConfig *tftags.DefaultConfig
+			if testCase.DefaultTags != nil {
+				defaultConfig = &tftags.DefaultConfig{Tags: tftags.New(context.Background(), testCase.DefaultTags)}
+			}
+			ctx := conns.NewResourceContext(context.Background(), "test", "Thing")
+			ctx = tftags.NewContext(ctx, defaultConfig, nil)
+			tagsInContext, _ := tftags.FromContext(ctx)
+			if testCase.TagsOut != nil {
+				tagsInContext.TagsOut = types.Some(tftags.New(ctx, testCase.TagsOut))
+			}
+			d := testCase.ResourceData
+			interceptor := NewTagsInterceptor(testCase.Tags)
+
+			// After interceptors are always preceded by the Before interceptor.
+			if testCase.When == After {
+				var diags Diagnostics
+				ctx, diags = interceptor.Run(ctx, &d, meta, Before, testCase.Why, nil)
+
+				if diags.HasError() {
+					t.Fatalf("unexpected Before error: %v", diags)
+				}
+			}
+
+			_, diags := interceptor.Run(ctx, &d, meta, testCase.When, testCase.Why, nil)
+
+			if got, want := diags.HasError(), testCase.ExpectError; got != want {
+				t.Fatalf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+
+			if got, want := len(diags) > 0 && !diags.HasError(), testCase.ExpectWarning; got != want {
+				t.Errorf("warnings = %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			if got, want := tagsInContext.TagsIn.UnwrapOrDefault().Map(), testCase.ExpectTagsIn; !cmp.Equal(got, want, cmpMapOpts...) {
+				t.Errorf("TagsIn = %v, want %v", got, want)
+			}
+
+			if got, want := tagsInContext.CreateTags, testCase.ExpectCreate; !cmp.Equal(got, want) {
+				t.Errorf("CreateTags = %v, want %v", got, want)
+			}
+
+			if got, want := sp.updated, testCase.ExpectUpdated; !cmp.Equal(got, want) {
+				t.Errorf("updated = %v, want %v", got, want)
+			}
+
+			if got, want := d.setTags, testCase.ExpectSetTags; !cmp.Equal(got, want, cmpMapOpts...) {
+				t.Errorf("tags = %v, want %v", got, want)
+			}
+
+			if got, want := d.setTagsAll, testCase.ExpectTagsAll; !cmp.Equal(got, want, cmpMapOpts...) {
+				t.Errorf("tags_all = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// cmpMapOpts treats nil and empty maps as equal.
+var cmpMapOpts = []cmp.Option{
+	cmp.FilterValues(func(x, y map[string]string) bool {
+		return len(x) == 0 && len(y) == 0
+	}, cmp.Comparer(func(x, y map[string]string) bool {
+		return true
+	})),
+}
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -90,3 +93,108 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestInterceptedHandlerRegionalClient(t *testing.T) {
+	t.Parallel()
+
+	client := &conns.AWSClient{Region: "us-west-2"}   //lintignore:AWSAT003
+	regional := &conns.AWSClient{Region: "eu-west-1"} //lintignore:AWSAT003
+
+	interceptors := interceptorItems{
+		{
+			when: Before,
+			why:  Read,
+			interceptor: interceptorFunc(func(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+				return conns.NewRegionalClientContext(ctx, regional), diags
+			}),
+		},
+		{
+			when: After,
+			why:  Read,
+			interceptor: interceptorFunc(func(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+				if meta != regional {
+					return ctx, sdkdiag.AppendErrorf(diags, "After interceptor called with provider Meta for Region %s", meta.(*conns.AWSClient).Region)
+				}
+
+				return ctx, diags
+			}),
+		},
+	}
+
+	var read schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if meta != regional {
+			return sdkdiag.AppendErrorf(diags, "handler called with provider Meta for Region %s", meta.(*conns.AWSClient).Region)
+		}
+
+		return diags
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, read, Read)(context.Background(), nil, client)
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestInterceptedHandlerShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	record := func(name string) interceptorFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			calls = append(calls, fmt.Sprintf("%s %d", name, when))
+
+			if name == "second" && when == Before {
+				return ctx, sdkdiag.AppendErrorf(diags, "Before error")
+			}
+
+			return ctx, diags
+		}
+	}
+
+	interceptors := interceptorItems{
+		{
+			when:        Before | After | OnError | Finally,
+			why:         Read,
+			interceptor: record("first"),
+		},
+		{
+			when:        Before | After | OnError | Finally,
+			why:         Read,
+			interceptor: record("second"),
+		},
+		{
+			when:        Before | After | OnError | Finally,
+			why:         Read,
+			interceptor: record("third"),
+		},
+	}
+
+	var read schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		calls = append(calls, "handler")
+
+		return nil
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, read, Read)(context.Background(), nil, 42)
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("length of diags = %v, want %v", got, want)
+	}
+
+	want := []string{
+		fmt.Sprintf("first %d", Before),
+		fmt.Sprintf("second %d", Before),
+		fmt.Sprintf("first %d", OnError),
+		fmt.Sprintf("first %d", Finally),
+	}
+	if got := calls; !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+}
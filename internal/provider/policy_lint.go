@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	validIAMPolicyJSONPointer            = reflect.ValueOf(verify.ValidIAMPolicyJSON).Pointer()
+	suppressEquivalentPolicyDiffsPointer = reflect.ValueOf(verify.SuppressEquivalentPolicyDiffs).Pointer()
+)
+
+// policyLintRuleValues returns the names of all policy lint rules.
+func policyLintRuleValues() []string {
+	var values []string
+
+	for _, v := range tfpolicy.LintRules() {
+		values = append(values, string(v))
+	}
+
+	return values
+}
+
+// addPolicyLintValidation adds policy lint warnings to the validation of every
+// policy document attribute in the resource's or data source's schema, including nested blocks.
+// An attribute is considered to hold a policy document if it is validated with
+// verify.ValidIAMPolicyJSON or suppresses diffs with verify.SuppressEquivalentPolicyDiffs.
+//
+// Lint findings depend on the provider's policy_lint configuration so are only
+// reported once the provider has been configured, i.e. during plan.
+func addPolicyLintValidation(provider *schema.Provider, r *schema.Resource) {
+	addPolicyLintValidationToSchemaMap(provider, r.Schema, make(map[*schema.Schema]struct{}))
+}
+
+func addPolicyLintValidationToSchemaMap(provider *schema.Provider, m map[string]*schema.Schema, seen map[*schema.Schema]struct{}) {
+	for _, v := range m {
+		if v == nil {
+			continue
+		}
+
+		// Schemas may be shared between attributes.
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			addPolicyLintValidationToSchemaMap(provider, r.Schema, seen)
+			continue
+		}
+
+		if isPolicyDocumentSchema(v) {
+			v.ValidateDiagFunc = policyLintValidateDiagFunc(provider, v.ValidateFunc, v.ValidateDiagFunc)
+			v.ValidateFunc = nil
+		}
+	}
+}
+
+func isPolicyDocumentSchema(v *schema.Schema) bool {
+	if v.Type != schema.TypeString || (v.Computed && !v.Optional && !v.Required) {
+		return false
+	}
+
+	if v.ValidateFunc != nil && reflect.ValueOf(v.ValidateFunc).Pointer() == validIAMPolicyJSONPointer {
+		return true
+	}
+
+	if v.DiffSuppressFunc != nil && reflect.ValueOf(v.DiffSuppressFunc).Pointer() == suppressEquivalentPolicyDiffsPointer {
+		return true
+	}
+
+	return false
+}
+
+func policyLintValidateDiagFunc(provider *schema.Provider, validateFunc schema.SchemaValidateFunc, validateDiagFunc schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	if validateFunc != nil {
+		validateDiagFunc = validation.ToDiagFunc(validateFunc)
+	}
+
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if validateDiagFunc != nil {
+			diags = append(diags, validateDiagFunc(v, path)...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		meta, ok := provider.Meta().(*conns.AWSClient)
+
+		// The provider is not yet configured.
+		if !ok || meta.PolicyLintConfig == nil {
+			return diags
+		}
+
+		value, ok := v.(string)
+
+		if !ok {
+			return diags
+		}
+
+		for _, finding := range tfpolicy.LintJSON(value, meta.PolicyLintConfig) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Policy document lint finding",
+				Detail:        finding.String(),
+				AttributePath: path,
+			})
+		}
+
+		return diags
+	}
+}
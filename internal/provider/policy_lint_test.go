@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestAddPolicyLintValidation(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := &schema.Provider{}
+
+	addPolicyLintValidation(provider, r)
+
+	if v := r.Schema["name"]; v.ValidateDiagFunc != nil {
+		t.Errorf("unexpected ValidateDiagFunc for name")
+	}
+
+	policy := r.Schema["policy"]
+	nested := r.Schema["statement"].Elem.(*schema.Resource).Schema["policy"]
+
+	for _, v := range []*schema.Schema{policy, nested} {
+		if v.ValidateFunc != nil || v.ValidateDiagFunc == nil {
+			t.Fatalf("expected ValidateDiagFunc only")
+		}
+	}
+
+	const wildcard = `{"Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`
+	path := cty.GetAttrPath("policy")
+
+	// Unconfigured provider.
+	if diags := policy.ValidateDiagFunc(wildcard, path); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	// Original validation still applies.
+	if diags := policy.ValidateDiagFunc(`{`, path); !diags.HasError() {
+		t.Errorf("expected error diagnostics")
+	}
+
+	provider.SetMeta(&conns.AWSClient{PolicyLintConfig: &tfpolicy.LintConfig{}})
+
+	for _, v := range []*schema.Schema{policy, nested} {
+		diags := v.ValidateDiagFunc(wildcard, path)
+
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			t.Errorf("expected one warning, got: %v", diags)
+		}
+	}
+
+	provider.SetMeta(&conns.AWSClient{PolicyLintConfig: &tfpolicy.LintConfig{
+		DisabledRules: map[tfpolicy.LintRule]struct{}{tfpolicy.LintRuleWildcardActionResource: {}},
+	}})
+
+	if diags := policy.ValidateDiagFunc(wildcard, path); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
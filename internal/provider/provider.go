@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -16,9 +17,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/intercept"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -43,8 +50,41 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"api_recorder": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for recording AWS API interactions to, or replaying them from, a cassette file.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cassette": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path of the cassette file.",
+						},
+						"mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[vcr.Mode](),
+							Description:      "Either `RECORDING` or `REPLAYING`.",
+						},
+					},
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_tag_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Update the tags of resources identified by ARN in batches using the Resource Groups Tagging API, " +
+					"falling back to the service API for resources whose tags cannot be updated in a batch.",
+			},
+			"credentials_diagnostics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Report which credential sources were checked when credentials cannot be resolved, " +
+					"and log how credentials were resolved. Secret values are never reported.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -59,6 +99,55 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types that have no default resource tags.",
+						},
+						"exclude_services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							},
+							Set:         schema.HashString,
+							Description: "Services, as used in the `endpoints` block, whose resources have no default resource tags.",
+						},
+						"scoped_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tags to default across the resources of the specified services and resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource types to default resource tags across. If not set, all resource types.",
+									},
+									"services": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+										},
+										Set:         schema.HashString,
+										Description: "Services, as used in the `endpoints` block, to default resource tags across. If not set, all services.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across the resources.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -124,6 +213,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"interceptor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings for an interceptor run around every resource operation. Interceptors run in the order configured.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(interceptors.Names(), false),
+							Description:  "Name of the interceptor.",
+						},
+						"options": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Interceptor-specific options.",
+						},
+					},
+				},
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -131,18 +241,116 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"policy_lint": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for the policy document lint warnings reported during plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disabled_rules": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(policyLintRuleValues(), false),
+							},
+							Set:         schema.HashString,
+							Description: "Policy lint rules to disable across all resources.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings for client-side rate limiting of requests to an AWS service or API operation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of requests sent at once. Defaults to `requests_per_second`.",
+						},
+						"operation": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the API operation to rate limit. If not set, all operations of the service are rate limited.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+							Description:  "Maximum sustained rate of requests.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							Description:  "Name of the service to rate limit, as used in the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings for the retries of resource operations, across all services or for a single service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backoff_base": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Wait before the first retry, doubled before each later retry.",
+						},
+						"backoff_cap": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Longest wait between retries. Defaults to `10s`.",
+						},
+						"iam_propagation_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Minimum time to retry AWS errors that resources retry by error code or message, such as those caused by IAM eventual consistency.",
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of attempts before a retryable error is returned.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Additional AWS error codes that are retried.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							Description:  "Name of the service the settings apply to, as used in the `endpoints` block. If not set, the settings apply to all services.",
+						},
+					},
+				},
+			},
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -224,6 +432,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a tag policy that resource tags are validated against across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Values allowed for the resource tag key.",
+									},
+								},
+							},
+							Description: "Values allowed for resource tag keys.",
+						},
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyEnforcementValues(), false),
+							Description:  "Whether tag policy violations are reported as errors or warnings. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"max_tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Maximum number of resource tags, keyed by the name of the service as used in the `endpoints` block.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys required across all resources.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -281,12 +539,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
+					ctx = tfresource.NewRetryPolicyContext(ctx, v.RetryConfig.ForService(servicePackageName))
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+
+			if addRegionAttribute(r, true) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -295,6 +563,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.ReadWithoutTimeout; v != nil {
 				r.ReadWithoutTimeout = ds.Read(v)
 			}
+			addPolicyLintValidation(provider, r)
 
 			provider.DataSourcesMap[typeName] = r
 		}
@@ -332,12 +601,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
+					ctx = tfresource.NewRetryPolicyContext(ctx, v.RetryConfig.ForService(servicePackageName))
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+			region := addRegionAttribute(r, false)
+
+			if region {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			interceptors = append(interceptors, interceptorItems{
+				{
+					when:        Before | After | OnError,
+					why:         AllOps,
+					interceptor: commonInterceptor{interceptor: intercept.NewConfiguredInterceptor(typeName)},
+				},
+				{
+					when:        Before | After | OnError,
+					why:         AllOps,
+					interceptor: commonInterceptor{interceptor: intercept.NewAPICallJournalInterceptor(typeName)},
+				},
+			}...)
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -361,16 +653,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
+				if v.Tags.ForceNew() {
+					r.Schema[names.AttrTags].ForceNew = true
+					r.Schema[names.AttrTagsAll].ForceNew = true
+				}
+
 				interceptors = append(interceptors, interceptorItem{
 					when:        Before | After,
 					why:         Create | Read | Update,
-					interceptor: tagsInterceptor{tags: v.Tags},
+					interceptor: commonInterceptor{interceptor: intercept.NewTagsInterceptor(v.Tags)},
 				})
 			}
 
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				region:           region,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -390,9 +688,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
+			if v := r.CustomizeDiff; v != nil || region {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
+			addPolicyLintValidation(provider, r)
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
 					stateUpgrader.Upgrade = rs.StateUpgrade(v)
@@ -433,6 +732,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		BatchTagUpdates:                d.Get("batch_tag_updates").(bool),
+		CredentialsDiagnostics:         d.Get("credentials_diagnostics").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -459,14 +760,31 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_recorder"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.APIRecorderConfig = expandAPIRecorder(ctx, v.([]interface{})[0].(map[string]interface{}))
+	} else if v, err := vcr.ConfigFromEnv(); err != nil {
+		return nil, diag.FromErr(err)
+	} else {
+		config.APIRecorderConfig = v
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+		assumeRoles, err := expandAssumeRoles(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.AssumeRole = assumeRoles
+		for i, v := range config.AssumeRole {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.hop":             i + 1,
+				"tf_aws.assume_role.role_arn":        v.RoleARN,
+				"tf_aws.assume_role.session_name":    v.SessionName,
+				"tf_aws.assume_role.external_id":     v.ExternalID,
+				"tf_aws.assume_role.source_identity": v.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -478,7 +796,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		defaultTagsConfig, err := expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.DefaultTagsConfig = defaultTagsConfig
 	}
 
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
@@ -499,10 +823,45 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("interceptor"); ok && len(v.([]interface{})) > 0 {
+		chain, err := expandInterceptors(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.Interceptors = chain
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	config.PolicyLintConfig = &tfpolicy.LintConfig{}
+	if v, ok := d.GetOk("policy_lint"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.PolicyLintConfig = expandPolicyLint(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 {
+		retryConfig, err := expandRetry(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RetryConfig = retryConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -521,6 +880,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
@@ -536,27 +905,26 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+// assumeRoleSchema returns the schema of the `assume_role` block.
+// Multiple blocks form a chain: each IAM Role is assumed in turn using the credentials of the previous one.
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  validAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.duration instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -694,6 +1062,57 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAPIRecorder(_ context.Context, tfMap map[string]interface{}) *vcr.Config {
+	if tfMap == nil {
+		return nil
+	}
+
+	config := &vcr.Config{}
+
+	if v, ok := tfMap["cassette"].(string); ok && v != "" {
+		config.Cassette = v
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		config.Mode = vcr.Mode(v)
+	}
+
+	return config
+}
+
+// expandAssumeRoles expands the ordered list of `assume_role` blocks into a chain of IAM Roles to assume.
+// Errors identify the block by its position in the chain.
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) ([]*awsbase.AssumeRole, error) {
+	var assumeRoles []*awsbase.AssumeRole
+	n := len(tfList)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			if n > 1 {
+				return nil, fmt.Errorf("assume_role %d of %d: role_arn must be set when more than one assume_role block is configured", i+1, n)
+			}
+
+			continue
+		}
+
+		if v1, v2 := tfMap["duration"], tfMap["duration_seconds"]; v1 != nil && v1 != "" && v2 != nil && v2 != 0 {
+			return nil, fmt.Errorf("assume_role %d of %d: only one of duration and duration_seconds can be set", i+1, n)
+		}
+
+		assumeRole := expandAssumeRole(ctx, tfMap)
+
+		if assumeRole.RoleARN == "" && n > 1 {
+			return nil, fmt.Errorf("assume_role %d of %d: role_arn must be set when more than one assume_role block is configured", i+1, n)
+		}
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles, nil
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -784,18 +1203,81 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
-func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
+func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.DefaultConfig, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludedResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+		services, err := expandServicePackageNames(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		defaultConfig.ExcludedServices = services
+	}
+
+	if v, ok := tfMap["scoped_tags"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			scopedTags := tftags.DefaultScopedTags{}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+				scopedTags.ResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+				services, err := expandServicePackageNames(v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				scopedTags.Services = services
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				scopedTags.Tags = tftags.New(ctx, v)
+			}
+
+			defaultConfig.ScopedTags = append(defaultConfig.ScopedTags, scopedTags)
+		}
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
-	return defaultConfig
+	return defaultConfig, nil
+}
+
+// expandServicePackageNames returns the service package names of the specified service aliases.
+func expandServicePackageNames(set *schema.Set) ([]string, error) {
+	var services []string
+
+	for _, v := range set.List() {
+		pkg, err := names.ProviderPackageForAlias(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign default tags (%s): %w", v, err)
+		}
+
+		services = append(services, pkg)
+	}
+
+	return services, nil
 }
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
@@ -816,6 +1298,214 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandInterceptors(_ context.Context, tfList []interface{}) (interceptors.Chain, error) {
+	var chain interceptors.Chain
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var options map[string]string
+
+		if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+			options = flex.ExpandStringValueMap(v)
+		}
+
+		interceptor, err := interceptors.New(tfMap["name"].(string), options)
+
+		if err != nil {
+			return nil, err
+		}
+
+		chain = append(chain, interceptor)
+	}
+
+	return chain, nil
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.AllowedValues = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if key, ok := tfMap["key"].(string); ok && key != "" {
+				if values, ok := tfMap["values"].(*schema.Set); ok {
+					policyConfig.AllowedValues[key] = flex.ExpandStringValueSet(values)
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		policyConfig.Enforcement = v
+	}
+
+	if v, ok := tfMap["max_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.MaxTags = make(map[string]int)
+
+		for k, v := range v {
+			pkg, err := names.ProviderPackageForAlias(k)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign tag policy maximum tags (%s): %w", k, err)
+			}
+
+			policyConfig.MaxTags[pkg] = v.(int)
+		}
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	return policyConfig, nil
+}
+
+func expandPolicyLint(_ context.Context, tfMap map[string]interface{}) *tfpolicy.LintConfig {
+	lintConfig := &tfpolicy.LintConfig{}
+
+	if tfMap == nil {
+		return lintConfig
+	}
+
+	if v, ok := tfMap["disabled_rules"].(*schema.Set); ok && v.Len() > 0 {
+		lintConfig.DisabledRules = make(map[tfpolicy.LintRule]struct{})
+
+		for _, v := range v.List() {
+			lintConfig.DisabledRules[tfpolicy.LintRule(v.(string))] = struct{}{}
+		}
+	}
+
+	return lintConfig
+}
+
+func expandRateLimits(_ context.Context, tfList []interface{}) ([]conns.RateLimit, error) {
+	var rateLimits []conns.RateLimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rateLimit := conns.RateLimit{}
+
+		if v, ok := tfMap["service"].(string); ok && v != "" {
+			pkg, err := names.ProviderPackageForAlias(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign rate limit (%s): %w", v, err)
+			}
+
+			rateLimit.Service = pkg
+		}
+
+		if v, ok := tfMap["operation"].(string); ok && v != "" {
+			rateLimit.Operation = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["burst"].(int); ok && v != 0 {
+			rateLimit.Burst = v
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits, nil
+}
+
+func expandRetry(_ context.Context, tfList []interface{}) (*tfresource.RetryConfig, error) {
+	retryConfig := &tfresource.RetryConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		policy := &tfresource.RetryPolicy{}
+
+		if v, ok := tfMap["backoff_base"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			policy.BackoffBase = duration
+		}
+
+		if v, ok := tfMap["backoff_cap"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+
+			if duration >= 3*time.Minute {
+				return nil, fmt.Errorf("failed to assign retry settings: backoff_cap (%s) must be less than 3m", v)
+			}
+
+			policy.BackoffCap = duration
+		}
+
+		if v, ok := tfMap["iam_propagation_timeout"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			policy.IAMPropagationTimeout = duration
+		}
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v != 0 {
+			policy.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			policy.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		v, ok := tfMap["service"].(string)
+
+		if !ok || v == "" {
+			if retryConfig.Global != nil {
+				return nil, errors.New("failed to assign retry settings: more than one block for all services")
+			}
+
+			retryConfig.Global = policy
+
+			continue
+		}
+
+		pkg, err := names.ProviderPackageForAlias(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign retry settings (%s): %w", v, err)
+		}
+
+		if _, ok := retryConfig.Services[pkg]; ok {
+			return nil, fmt.Errorf("failed to assign retry settings (%s): more than one block for the service", v)
+		}
+
+		if retryConfig.Services == nil {
+			retryConfig.Services = make(map[string]*tfresource.RetryPolicy)
+		}
+
+		retryConfig.Services[pkg] = policy
+	}
+
+	return retryConfig, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -202,3 +202,77 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input         []interface{}
+		expectedARNs  []string
+		expectedError string
+	}{
+		"empty block": {
+			input: []interface{}{nil},
+		},
+		"single": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/hub"},
+			},
+			expectedARNs: []string{"arn:aws:iam::111111111111:role/hub"},
+		},
+		"chain": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/hub", "session_name": "hub"},
+				map[string]interface{}{"role_arn": "arn:aws:iam::222222222222:role/spoke", "external_id": "spoke"},
+			},
+			expectedARNs: []string{"arn:aws:iam::111111111111:role/hub", "arn:aws:iam::222222222222:role/spoke"},
+		},
+		"chain missing role_arn": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/hub"},
+				map[string]interface{}{"role_arn": ""},
+			},
+			expectedError: "assume_role 2 of 2: role_arn must be set",
+		},
+		"duration conflict": {
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/hub", "duration": "1h", "duration_seconds": 3600},
+			},
+			expectedError: "assume_role 1 of 1: only one of duration and duration_seconds can be set",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := expandAssumeRoles(ctx, testCase.input)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(results) != len(testCase.expectedARNs) {
+				t.Fatalf("expected %d roles, got %d", len(testCase.expectedARNs), len(results))
+			}
+
+			for i, v := range results {
+				if v.RoleARN != testCase.expectedARNs[i] {
+					t.Errorf("role %d: expected %q, got %q", i+1, testCase.expectedARNs[i], v.RoleARN)
+				}
+			}
+		})
+	}
+}
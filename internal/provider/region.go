@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// addRegionAttribute adds the optional `region` argument to the schema of a resource or data source.
+// It returns false if the schema already defines a `region` attribute.
+func addRegionAttribute(r *schema.Resource, dataSource bool) bool {
+	if _, ok := r.Schema[names.AttrRegion]; ok {
+		return false
+	}
+
+	v := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "Region where the resource is managed. Defaults to the region set in the provider configuration.",
+	}
+
+	if !dataSource {
+		v.ForceNew = true
+	}
+
+	r.Schema[names.AttrRegion] = v
+
+	return true
+}
+
+// regionInterceptor runs a resource's or data source's CRUD handlers using the provider Meta for the configured Region.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	client, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		region := d.Get(names.AttrRegion).(string)
+
+		regional, newDiags := client.ForRegion(ctx, region)
+
+		diags = append(diags, newDiags...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		ctx = conns.NewRegionalClientContext(ctx, regional)
+
+		// The Region that the resource is managed in, or that the data source was read from, is stored in state.
+		if why != Delete {
+			if err := d.Set(names.AttrRegion, regional.Region); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// customizeRegionDiff plans the Region that a resource is managed in.
+// An unconfigured `region` defaults to the provider's Region and a resource whose state has no `region`,
+// i.e. one created before the attribute was added, is in the provider's Region.
+// The resource is only replaced if the Region actually changes.
+func customizeRegionDiff(_ context.Context, d *schema.ResourceDiff, client *conns.AWSClient) (string, error) {
+	region, ok := plannedRegion(d, client)
+	if !ok {
+		return "", nil
+	}
+
+	old, _ := d.GetChange(names.AttrRegion)
+	if old := old.(string); d.Id() != "" && (old == region || (old == "" && region == client.Region)) {
+		if d.HasChange(names.AttrRegion) {
+			if err := d.Clear(names.AttrRegion); err != nil {
+				return "", err
+			}
+		}
+
+		return region, nil
+	}
+
+	if err := d.SetNew(names.AttrRegion, region); err != nil {
+		return "", err
+	}
+
+	return region, nil
+}
+
+// plannedRegion returns the configured `region`, or the provider's Region if none is configured.
+// It returns false if the Region is not yet known.
+func plannedRegion(d *schema.ResourceDiff, client *conns.AWSClient) (string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return "", false
+	}
+
+	v := config.GetAttr(names.AttrRegion)
+	if !v.IsKnown() {
+		return "", false
+	}
+
+	if v.IsNull() {
+		return client.Region, true
+	}
+
+	return v.AsString(), true
+}
+
+// importRegionSeparator separates an imported resource's ID from the Region that it is imported from.
+const importRegionSeparator = "@"
+
+// parseImportID splits the ID passed to `terraform import` into the resource ID and an optional Region suffix, e.g. `vpc-12345678@eu-west-1`.
+// An ID whose suffix isn't a Region name is returned unchanged.
+func parseImportID(id string) (string, string) {
+	i := strings.LastIndex(id, importRegionSeparator)
+	if i < 0 {
+		return id, ""
+	}
+
+	region := id[i+len(importRegionSeparator):]
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); region == "" || len(errs) > 0 {
+		return id, ""
+	}
+
+	return id[:i], region
+}
+
+// importRegion prepares a resource being imported to be read from the Region in its import ID, if any.
+func importRegion(ctx context.Context, d *schema.ResourceData, client *conns.AWSClient) (context.Context, *conns.AWSClient, error) {
+	id, region := parseImportID(d.Id())
+
+	regional, diags := client.ForRegion(ctx, region)
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		return ctx, nil, err
+	}
+
+	d.SetId(id)
+	if err := d.Set(names.AttrRegion, regional.Region); err != nil {
+		return ctx, nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	return conns.NewRegionalClientContext(ctx, regional), regional, nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			ID:         "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			ID:             "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			ID:         "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			ID:         "vpc-12345678@",
+			ExpectedID: "vpc-12345678@",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.ID, func(t *testing.T) {
+			t.Parallel()
+
+			id, region := parseImportID(testCase.ID)
+
+			if got, want := id, testCase.ExpectedID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+
+			if got, want := region, testCase.ExpectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -20,6 +21,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	applicationCreatedTimeout = 2 * time.Minute
+	applicationDeletedTimeout = 2 * time.Minute
+)
+
 // @SDKResource("aws_applicationinsights_application", name="Application")
 // @Tags(identifierAttribute="arn")
 func ResourceApplication() *schema.Resource {
@@ -104,7 +110,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(out.ApplicationInfo.ResourceGroupName))
 
-	if _, err := waitApplicationCreated(ctx, conn, d.Id()); err != nil {
+	if _, err := waitApplicationCreated(ctx, conn, d.Id(), applicationCreatedTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ApplicationInsights Application (%s) create: %s", d.Id(), err)
 	}
 
@@ -202,7 +208,7 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "Error deleting ApplicationInsights Application: %s", err)
 	}
 
-	if _, err := waitApplicationTerminated(ctx, conn, d.Id()); err != nil {
+	if _, err := waitApplicationDeleted(ctx, conn, d.Id(), applicationDeletedTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ApplicationInsights Application (%s) delete: %s", d.Id(), err)
 	}
 
@@ -0,0 +1,43 @@
+// Code generated by "internal/generate/waiters/main.go -Name=Application -Finder=FindApplicationByName -Status=LifeCycle -CreatedPending=CREATING -CreatedTarget=NOT_CONFIGURED -DeletedPending=NOT_CONFIGURED,DELETING"; DO NOT EDIT.
+
+package applicationinsights
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func applicationWaiter(conn *applicationinsights.ApplicationInsights, name string) *tfresource.Waiter[applicationinsights.ApplicationInfo] {
+	return &tfresource.Waiter[applicationinsights.ApplicationInfo]{
+		Find: func(ctx context.Context) (*applicationinsights.ApplicationInfo, error) {
+			return FindApplicationByName(ctx, conn, name)
+		},
+		Status: func(v *applicationinsights.ApplicationInfo) string {
+			return aws.StringValue(v.LifeCycle)
+		},
+		Created: tfresource.WaiterStates{
+			Pending: []string{"CREATING"},
+			Target:  []string{"NOT_CONFIGURED"},
+		},
+		Deleted: tfresource.WaiterStates{
+			Pending: []string{"NOT_CONFIGURED", "DELETING"},
+		},
+	}
+}
+
+func statusApplication(ctx context.Context, conn *applicationinsights.ApplicationInsights, name string) retry.StateRefreshFunc {
+	return applicationWaiter(conn, name).Refresh(ctx)
+}
+
+func waitApplicationCreated(ctx context.Context, conn *applicationinsights.ApplicationInsights, name string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*applicationinsights.ApplicationInfo, error) {
+	return applicationWaiter(conn, name).WaitCreated(ctx, timeout, optFns...)
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *applicationinsights.ApplicationInsights, name string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*applicationinsights.ApplicationInfo, error) {
+	return applicationWaiter(conn, name).WaitDeleted(ctx, timeout, optFns...)
+}
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/waiters/main.go -Name=Application -Finder=FindApplicationByName -Status=LifeCycle -CreatedPending=CREATING -CreatedTarget=NOT_CONFIGURED -DeletedPending=NOT_CONFIGURED,DELETING
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceARN -ServiceTagsSlice -TagInIDElem=ResourceARN -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
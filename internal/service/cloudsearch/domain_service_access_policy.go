@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -107,7 +108,7 @@ func resourceDomainServiceAccessPolicyRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain Service Access Policy (%s): %s", d.Id(), err)
 	}
 
-	policyToSet, err := verify.PolicyToSet(d.Get("access_policy").(string), accessPolicy)
+	policyToSet, err := tfpolicy.ToSet(d.Get("access_policy").(string), accessPolicy)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain Service Access Policy (%s): %s", d.Id(), err)
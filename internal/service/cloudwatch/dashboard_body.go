@@ -0,0 +1,670 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Dashboard body structure and syntax:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+
+const (
+	dashboardGridColumns       = 24
+	dashboardMaxWidgetHeight   = 1000
+	dashboardMaxWidgets        = 500
+	dashboardMaxWidgetAlarms   = 100
+	dashboardMaxLogGroups      = 50
+	dashboardDefaultWidgetSize = 6
+)
+
+const (
+	widgetTypeAlarm    = "alarm"
+	widgetTypeCustom   = "custom"
+	widgetTypeExplorer = "explorer"
+	widgetTypeLog      = "log"
+	widgetTypeMetric   = "metric"
+	widgetTypeText     = "text"
+)
+
+func widgetType_Values() []string {
+	return []string{
+		widgetTypeAlarm,
+		widgetTypeCustom,
+		widgetTypeExplorer,
+		widgetTypeLog,
+		widgetTypeMetric,
+		widgetTypeText,
+	}
+}
+
+const (
+	metricWidgetViewBar         = "bar"
+	metricWidgetViewGauge       = "gauge"
+	metricWidgetViewPie         = "pie"
+	metricWidgetViewSingleValue = "singleValue"
+	metricWidgetViewTimeSeries  = "timeSeries"
+)
+
+func metricWidgetView_Values() []string {
+	return []string{
+		metricWidgetViewBar,
+		metricWidgetViewGauge,
+		metricWidgetViewPie,
+		metricWidgetViewSingleValue,
+		metricWidgetViewTimeSeries,
+	}
+}
+
+const (
+	logWidgetViewBar        = "bar"
+	logWidgetViewPie        = "pie"
+	logWidgetViewTable      = "table"
+	logWidgetViewTimeSeries = "timeSeries"
+)
+
+func logWidgetView_Values() []string {
+	return []string{
+		logWidgetViewBar,
+		logWidgetViewPie,
+		logWidgetViewTable,
+		logWidgetViewTimeSeries,
+	}
+}
+
+func alarmWidgetSortBy_Values() []string {
+	return []string{
+		"default",
+		"stateUpdatedTimestamp",
+		"timestamp",
+	}
+}
+
+func textWidgetBackground_Values() []string {
+	return []string{
+		"solid",
+		"transparent",
+	}
+}
+
+func dashboardPeriodOverride_Values() []string {
+	return []string{
+		"auto",
+		"inherit",
+	}
+}
+
+func yAxis_Values() []string {
+	return []string{
+		"left",
+		"right",
+	}
+}
+
+var (
+	metricIDRegexp    = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+	metricColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	metricStatRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`^(SampleCount|Average|Sum|Minimum|Maximum|IQM)$`),
+		regexp.MustCompile(`^(p|tm|wm|tc|ts)(100|\d{1,2}(\.\d{1,10})?)$`),
+		regexp.MustCompile(`^(PR|TM|WM|TC|TS)\(.+\)$`),
+	}
+)
+
+func validMetricStat(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := validStat(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+
+	return
+}
+
+func validMetricPeriod(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if err := validPeriod(int64(value)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+
+	return
+}
+
+func validStat(s string) error {
+	for _, re := range metricStatRegexps {
+		if re.MatchString(s) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("is not a valid statistic: %q", s)
+}
+
+// validPeriod checks a period in seconds. High-resolution periods are 1, 5, 10 or 30
+// seconds, other periods must be a multiple of 60.
+func validPeriod(n int64) error {
+	switch {
+	case n == 1, n == 5, n == 10, n == 30:
+		return nil
+	case n > 0 && n%60 == 0:
+		return nil
+	}
+
+	return fmt.Errorf("must be 1, 5, 10, 30 or a multiple of 60, got: %d", n)
+}
+
+// validateDashboardBody checks a dashboard body against the dashboard body structure,
+// returning every problem found.
+func validateDashboardBody(body string) []error {
+	var doc interface{}
+
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+
+	if err := dec.Decode(&doc); err != nil {
+		return []error{fmt.Errorf("parsing dashboard body: %w", err)}
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return []error{errors.New("parsing dashboard body: unexpected data after top-level value")}
+	}
+
+	v := &dashboardBodyValidator{}
+	v.dashboard(doc)
+
+	return v.errs
+}
+
+type dashboardBodyValidator struct {
+	errs []error
+}
+
+func (v *dashboardBodyValidator) errorf(path, format string, a ...interface{}) {
+	if path == "" {
+		path = "dashboard body"
+	}
+
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
+
+func (v *dashboardBodyValidator) dashboard(raw interface{}) {
+	m, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.errorf("", "must be a JSON object")
+		return
+	}
+
+	for _, field := range []string{"start", "end"} {
+		if raw, ok := m[field]; ok {
+			if _, ok := raw.(string); !ok {
+				v.errorf(field, "must be a string")
+			}
+		}
+	}
+
+	v.oneOf("periodOverride", m["periodOverride"], dashboardPeriodOverride_Values())
+
+	raw, ok = m["widgets"]
+
+	if !ok {
+		v.errorf("widgets", "is required")
+		return
+	}
+
+	widgets, ok := raw.([]interface{})
+
+	if !ok {
+		v.errorf("widgets", "must be an array")
+		return
+	}
+
+	if len(widgets) > dashboardMaxWidgets {
+		v.errorf("widgets", "must contain at most %d widgets, got: %d", dashboardMaxWidgets, len(widgets))
+	}
+
+	for i, raw := range widgets {
+		v.widget(fmt.Sprintf("widgets[%d]", i), raw)
+	}
+}
+
+func (v *dashboardBodyValidator) widget(path string, raw interface{}) {
+	m, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.errorf(path, "must be a JSON object")
+		return
+	}
+
+	typ, ok := m["type"].(string)
+
+	if !ok {
+		v.errorf(joinPath(path, "type"), "is required")
+		return
+	}
+
+	if !v.oneOf(joinPath(path, "type"), typ, widgetType_Values()) {
+		return
+	}
+
+	x, xOK := v.integerBetween(joinPath(path, "x"), m["x"], 0, dashboardGridColumns-1)
+	v.integerBetween(joinPath(path, "y"), m["y"], 0, -1)
+	width, widthOK := v.integerBetween(joinPath(path, "width"), m["width"], 1, dashboardGridColumns)
+	v.integerBetween(joinPath(path, "height"), m["height"], 1, dashboardMaxWidgetHeight)
+
+	if xOK && widthOK && x+width > dashboardGridColumns {
+		v.errorf(path, "widget extends beyond column %d of the grid (x = %d, width = %d)", dashboardGridColumns, x, width)
+	}
+
+	raw, ok = m["properties"]
+
+	if !ok {
+		v.errorf(joinPath(path, "properties"), "is required")
+		return
+	}
+
+	properties, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.errorf(joinPath(path, "properties"), "must be a JSON object")
+		return
+	}
+
+	path = joinPath(path, "properties")
+
+	if raw, ok := properties["title"]; ok {
+		if _, ok := raw.(string); !ok {
+			v.errorf(joinPath(path, "title"), "must be a string")
+		}
+	}
+
+	switch typ {
+	case widgetTypeAlarm:
+		v.alarmWidget(path, properties)
+	case widgetTypeLog:
+		v.logWidget(path, properties)
+	case widgetTypeMetric:
+		v.metricWidget(path, properties)
+	case widgetTypeText:
+		v.textWidget(path, properties)
+	}
+}
+
+func (v *dashboardBodyValidator) alarmWidget(path string, m map[string]interface{}) {
+	alarms, ok := m["alarms"].([]interface{})
+
+	if !ok || len(alarms) == 0 {
+		v.errorf(joinPath(path, "alarms"), "must be a non-empty array of alarm ARNs")
+	} else if len(alarms) > dashboardMaxWidgetAlarms {
+		v.errorf(joinPath(path, "alarms"), "must contain at most %d alarms, got: %d", dashboardMaxWidgetAlarms, len(alarms))
+	}
+
+	for i, raw := range alarms {
+		if s, ok := raw.(string); !ok || !arn.IsARN(s) {
+			v.errorf(fmt.Sprintf("%s[%d]", joinPath(path, "alarms"), i), "must be an alarm ARN")
+		}
+	}
+
+	v.oneOf(joinPath(path, "sortBy"), m["sortBy"], alarmWidgetSortBy_Values())
+
+	if raw, ok := m["states"]; ok {
+		states, ok := raw.([]interface{})
+
+		if !ok {
+			v.errorf(joinPath(path, "states"), "must be an array")
+		}
+
+		for i, raw := range states {
+			v.oneOf(fmt.Sprintf("%s[%d]", joinPath(path, "states"), i), raw, []string{"ALARM", "INSUFFICIENT_DATA", "OK"})
+		}
+	}
+}
+
+func (v *dashboardBodyValidator) logWidget(path string, m map[string]interface{}) {
+	v.requiredString(joinPath(path, "region"), m["region"])
+
+	if query, ok := v.requiredString(joinPath(path, "query"), m["query"]); ok {
+		if !strings.HasPrefix(query, "SOURCE ") {
+			v.errorf(joinPath(path, "query"), "must start with the log groups to query, for example SOURCE 'log-group'")
+		} else if n := strings.Count(strings.SplitN(query, "|", 2)[0], "SOURCE "); n > dashboardMaxLogGroups {
+			v.errorf(joinPath(path, "query"), "must query at most %d log groups, got: %d", dashboardMaxLogGroups, n)
+		}
+	}
+
+	v.oneOf(joinPath(path, "view"), m["view"], logWidgetView_Values())
+	v.optionalBool(joinPath(path, "stacked"), m["stacked"])
+}
+
+func (v *dashboardBodyValidator) metricWidget(path string, m map[string]interface{}) {
+	v.requiredString(joinPath(path, "region"), m["region"])
+	v.oneOf(joinPath(path, "view"), m["view"], metricWidgetView_Values())
+
+	for _, field := range []string{"liveData", "setPeriodToTimeRange", "sparkline", "stacked"} {
+		v.optionalBool(joinPath(path, field), m[field])
+	}
+
+	v.optionalStat(joinPath(path, "stat"), m["stat"])
+	v.optionalPeriod(joinPath(path, "period"), m["period"])
+
+	raw, ok := m["metrics"]
+
+	if !ok {
+		v.errorf(joinPath(path, "metrics"), "is required")
+		return
+	}
+
+	metrics, ok := raw.([]interface{})
+
+	if !ok || len(metrics) == 0 {
+		v.errorf(joinPath(path, "metrics"), "must be a non-empty array")
+		return
+	}
+
+	type metricExpression struct {
+		path, id, expression string
+	}
+
+	ids := make(map[string]struct{})
+	var expressions []metricExpression
+
+	for i, raw := range metrics {
+		path := fmt.Sprintf("%s[%d]", joinPath(path, "metrics"), i)
+		row, ok := raw.([]interface{})
+
+		if !ok || len(row) == 0 {
+			v.errorf(path, "must be a non-empty array")
+			continue
+		}
+
+		var options map[string]interface{}
+
+		if m, ok := row[len(row)-1].(map[string]interface{}); ok {
+			options = m
+			row = row[:len(row)-1]
+		}
+
+		if len(row) == 0 {
+			// Math expression.
+			if expression, ok := v.requiredString(joinPath(path, "expression"), options["expression"]); ok {
+				if strings.TrimSpace(expression) == "" {
+					v.errorf(joinPath(path, "expression"), "must not be empty")
+				}
+
+				id, _ := options["id"].(string)
+				expressions = append(expressions, metricExpression{path: path, id: id, expression: expression})
+
+				if id == "" {
+					v.errorf(joinPath(path, "id"), "is required for math expressions")
+				}
+			}
+		} else {
+			v.metricRow(path, row)
+
+			if _, ok := options["expression"]; ok {
+				v.errorf(joinPath(path, "expression"), "cannot be specified for a metric")
+			}
+		}
+
+		if options == nil {
+			continue
+		}
+
+		if raw, ok := options["id"]; ok {
+			id, ok := raw.(string)
+
+			switch {
+			case !ok || !metricIDRegexp.MatchString(id):
+				v.errorf(joinPath(path, "id"), "must start with a lowercase letter and contain only letters, numbers and underscores")
+			default:
+				if _, ok := ids[id]; ok {
+					v.errorf(joinPath(path, "id"), "duplicate id %q", id)
+				}
+
+				ids[id] = struct{}{}
+			}
+		}
+
+		if raw, ok := options["label"]; ok {
+			if _, ok := raw.(string); !ok {
+				v.errorf(joinPath(path, "label"), "must be a string")
+			}
+		}
+
+		if raw, ok := options["color"]; ok {
+			if s, ok := raw.(string); !ok || !metricColorRegexp.MatchString(s) {
+				v.errorf(joinPath(path, "color"), "must be a six-digit hex color, for example #1f77b4")
+			}
+		}
+
+		v.optionalBool(joinPath(path, "visible"), options["visible"])
+		v.oneOf(joinPath(path, "yAxis"), options["yAxis"], yAxis_Values())
+		v.optionalStat(joinPath(path, "stat"), options["stat"])
+		v.optionalPeriod(joinPath(path, "period"), options["period"])
+	}
+
+	for _, e := range expressions {
+		for _, ref := range metricMathReferences(e.expression) {
+			if ref == e.id {
+				v.errorf(joinPath(e.path, "expression"), "must not reference its own id %q", ref)
+			} else if _, ok := ids[ref]; !ok {
+				v.errorf(joinPath(e.path, "expression"), "references unknown metric id %q", ref)
+			}
+		}
+	}
+}
+
+// metricRow checks the namespace, metric name and dimension name/value pairs of a metric.
+// "." repeats the value at the same position in the previous metric and "..." repeats the
+// remaining values of the previous metric.
+func (v *dashboardBodyValidator) metricRow(path string, row []interface{}) {
+	shorthand := false
+
+	for i, raw := range row {
+		s, ok := raw.(string)
+
+		if !ok {
+			v.errorf(fmt.Sprintf("%s[%d]", path, i), "must be a string")
+			return
+		}
+
+		if s == "." || s == "..." {
+			shorthand = true
+		}
+	}
+
+	if shorthand {
+		return
+	}
+
+	if len(row) < 2 {
+		v.errorf(path, "must specify a namespace and a metric name")
+	} else if len(row)%2 != 0 {
+		v.errorf(path, "dimension %q has no value", row[len(row)-1])
+	}
+}
+
+func (v *dashboardBodyValidator) textWidget(path string, m map[string]interface{}) {
+	v.requiredString(joinPath(path, "markdown"), m["markdown"])
+	v.oneOf(joinPath(path, "background"), m["background"], textWidgetBackground_Values())
+}
+
+func (v *dashboardBodyValidator) requiredString(path string, raw interface{}) (string, bool) {
+	if raw == nil {
+		v.errorf(path, "is required")
+		return "", false
+	}
+
+	s, ok := raw.(string)
+
+	if !ok {
+		v.errorf(path, "must be a string")
+	}
+
+	return s, ok
+}
+
+// oneOf checks that an optional value is one of the specified strings.
+func (v *dashboardBodyValidator) oneOf(path string, raw interface{}, values []string) bool {
+	if raw == nil {
+		return true
+	}
+
+	if s, ok := raw.(string); ok {
+		for _, value := range values {
+			if s == value {
+				return true
+			}
+		}
+	}
+
+	v.errorf(path, "expected to be one of %q, got: %v", values, raw)
+
+	return false
+}
+
+func (v *dashboardBodyValidator) optionalBool(path string, raw interface{}) {
+	if raw == nil {
+		return
+	}
+
+	if _, ok := raw.(bool); !ok {
+		v.errorf(path, "must be true or false")
+	}
+}
+
+func (v *dashboardBodyValidator) optionalStat(path string, raw interface{}) {
+	if raw == nil {
+		return
+	}
+
+	s, ok := raw.(string)
+
+	if !ok {
+		v.errorf(path, "must be a string")
+		return
+	}
+
+	if err := validStat(s); err != nil {
+		v.errorf(path, "%s", err)
+	}
+}
+
+func (v *dashboardBodyValidator) optionalPeriod(path string, raw interface{}) {
+	if raw == nil {
+		return
+	}
+
+	n, err := integer(raw)
+
+	if err != nil {
+		v.errorf(path, "%s", err)
+		return
+	}
+
+	if err := validPeriod(n); err != nil {
+		v.errorf(path, "%s", err)
+	}
+}
+
+// integerBetween checks that a value is an integer between min and max inclusive.
+// A negative max means there is no upper bound. Missing values are allowed.
+func (v *dashboardBodyValidator) integerBetween(path string, raw interface{}, min, max int64) (int64, bool) {
+	if raw == nil {
+		return 0, false
+	}
+
+	n, err := integer(raw)
+
+	if err != nil {
+		v.errorf(path, "%s", err)
+		return 0, false
+	}
+
+	if n < min || (max >= 0 && n > max) {
+		if max < 0 {
+			v.errorf(path, "must be at least %d, got: %d", min, n)
+		} else {
+			v.errorf(path, "must be between %d and %d, got: %d", min, max, n)
+		}
+
+		return 0, false
+	}
+
+	return n, true
+}
+
+func integer(raw interface{}) (int64, error) {
+	if n, ok := raw.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("must be an integer, got: %v", raw)
+}
+
+// metricMathReferences returns the metric ids referenced by a metric math expression.
+// Functions and keywords are upper case, and metric ids start with a lower case letter.
+// Metrics Insights queries, which reference metric names rather than ids, are skipped.
+func metricMathReferences(expression string) []string {
+	if fields := strings.Fields(expression); len(fields) > 0 && strings.EqualFold(fields[0], "SELECT") {
+		return nil
+	}
+
+	var refs []string
+	seen := make(map[string]struct{})
+
+	for i := 0; i < len(expression); {
+		c := expression[i]
+
+		switch {
+		case c == '\'' || c == '"':
+			// Skip string literals such as SEARCH expressions and METRICS('label') filters.
+			end := strings.IndexByte(expression[i+1:], c)
+
+			if end < 0 {
+				return refs
+			}
+
+			i += end + 2
+		case isIdentifierByte(c):
+			j := i
+
+			for j < len(expression) && isIdentifierByte(expression[j]) {
+				j++
+			}
+
+			token := expression[i:j]
+			i = j
+
+			if metricIDRegexp.MatchString(token) {
+				if _, ok := seen[token]; !ok {
+					seen[token] = struct{}{}
+					refs = append(refs, token)
+				}
+			}
+		default:
+			i++
+		}
+	}
+
+	return refs
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
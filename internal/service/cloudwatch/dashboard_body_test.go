@@ -0,0 +1,214 @@
+package cloudwatch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateDashboardBody(t *testing.T) {
+	t.Parallel()
+
+	widget := func(typ, properties string) string {
+		return `{"widgets": [{"type": "` + typ + `", "x": 0, "y": 0, "width": 12, "height": 6, "properties": ` + properties + `}]}`
+	}
+
+	testCases := map[string]struct {
+		body          string
+		expectedError string
+	}{
+		"metric": {
+			body: widget("metric", `{"region": "us-west-2", "stat": "p99.9", "period": 300, "metrics": [
+				["AWS/EC2", "CPUUtilization", "InstanceId", "i-1234567890abcdef0", {"id": "m1", "visible": false}],
+				[".", "NetworkIn", ".", ".", {"id": "m2"}],
+				["...", "i-0fedcba0987654321"],
+				[{"expression": "(m1 + m2) / 2 * 1e2", "id": "e1", "label": "Average", "color": "#1f77b4"}],
+				[{"expression": "SEARCH('{AWS/EC2,InstanceId} MetricName=\"CPUUtilization\"', 'Average', 300)", "id": "e2"}],
+				[{"expression": "SELECT AVG(latency) FROM SCHEMA(\"Custom\", host)", "id": "q1"}]
+			]}`),
+		},
+		"single value": {
+			body: widget("metric", `{"region": "us-west-2", "view": "singleValue", "sparkline": true, "metrics": [["AWS/SQS", "NumberOfMessagesSent", "QueueName", "test"]]}`),
+		},
+		"log": {
+			body: widget("log", `{"region": "us-west-2", "view": "table", "query": "SOURCE 'test' | fields @timestamp, @message | limit 20"}`),
+		},
+		"alarm": {
+			body: widget("alarm", `{"alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"], "sortBy": "stateUpdatedTimestamp", "states": ["ALARM", "OK"]}`), //lintignore:AWSAT003,AWSAT005
+		},
+		"text": {
+			body: widget("text", `{"markdown": "# Hello", "background": "transparent"}`),
+		},
+		"explorer": {
+			body: widget("explorer", `{"metrics": [{"metricName": "CPUUtilization", "resourceType": "AWS::EC2::Instance"}]}`),
+		},
+		"invalid JSON": {
+			body:          `{"widgets": [}`,
+			expectedError: "parsing dashboard body",
+		},
+		"widgets missing": {
+			body:          `{"start": "-PT6H"}`,
+			expectedError: "widgets: is required",
+		},
+		"period override": {
+			body:          `{"periodOverride": "manual", "widgets": []}`,
+			expectedError: "periodOverride: expected to be one of",
+		},
+		"widget type": {
+			body:          widget("graph", `{}`),
+			expectedError: "widgets[0].type: expected to be one of",
+		},
+		"widget width": {
+			body:          `{"widgets": [{"type": "text", "width": 25, "properties": {"markdown": "x"}}]}`,
+			expectedError: "widgets[0].width: must be between 1 and 24, got: 25",
+		},
+		"widget beyond grid": {
+			body:          `{"widgets": [{"type": "text", "x": 18, "width": 12, "properties": {"markdown": "x"}}]}`,
+			expectedError: "widget extends beyond column 24 of the grid",
+		},
+		"properties missing": {
+			body:          `{"widgets": [{"type": "text"}]}`,
+			expectedError: "widgets[0].properties: is required",
+		},
+		"metric region": {
+			body:          widget("metric", `{"metrics": [["AWS/EC2", "CPUUtilization"]]}`),
+			expectedError: "widgets[0].properties.region: is required",
+		},
+		"metric metrics": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": []}`),
+			expectedError: "widgets[0].properties.metrics: must be a non-empty array",
+		},
+		"metric view": {
+			body:          widget("metric", `{"region": "us-west-2", "view": "table", "metrics": [["AWS/EC2", "CPUUtilization"]]}`),
+			expectedError: "widgets[0].properties.view: expected to be one of",
+		},
+		"metric stat": {
+			body:          widget("metric", `{"region": "us-west-2", "stat": "Median", "metrics": [["AWS/EC2", "CPUUtilization"]]}`),
+			expectedError: `is not a valid statistic: "Median"`,
+		},
+		"metric period": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"period": 90}]]}`),
+			expectedError: "metrics[0].period: must be 1, 5, 10, 30 or a multiple of 60, got: 90",
+		},
+		"metric dimension": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId"]]}`),
+			expectedError: `metrics[0]: dimension "InstanceId" has no value`,
+		},
+		"metric id": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"id": "M1"}]]}`),
+			expectedError: "metrics[0].id: must start with a lowercase letter",
+		},
+		"metric duplicate id": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"id": "m1"}], ["AWS/EC2", "NetworkIn", {"id": "m1"}]]}`),
+			expectedError: `metrics[1].id: duplicate id "m1"`,
+		},
+		"metric color": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"color": "red"}]]}`),
+			expectedError: "metrics[0].color: must be a six-digit hex color",
+		},
+		"expression id": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [[{"expression": "SUM(METRICS())"}]]}`),
+			expectedError: "metrics[0].id: is required for math expressions",
+		},
+		"expression unknown id": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"id": "m1"}], [{"expression": "m1 + m2", "id": "e1"}]]}`),
+			expectedError: `metrics[1].expression: references unknown metric id "m2"`,
+		},
+		"expression self reference": {
+			body:          widget("metric", `{"region": "us-west-2", "metrics": [[{"expression": "e1 * 2", "id": "e1"}]]}`),
+			expectedError: `metrics[0].expression: must not reference its own id "e1"`,
+		},
+		"log query": {
+			body:          widget("log", `{"region": "us-west-2", "query": "fields @message"}`),
+			expectedError: "widgets[0].properties.query: must start with the log groups to query",
+		},
+		"alarm alarms": {
+			body:          widget("alarm", `{"alarms": ["test"]}`),
+			expectedError: "widgets[0].properties.alarms[0]: must be an alarm ARN",
+		},
+		"alarm states": {
+			body:          widget("alarm", `{"alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"], "states": ["FIRING"]}`), //lintignore:AWSAT003,AWSAT005
+			expectedError: "widgets[0].properties.states[0]: expected to be one of",
+		},
+		"text markdown": {
+			body:          widget("text", `{"background": "solid"}`),
+			expectedError: "widgets[0].properties.markdown: is required",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validateDashboardBody(testCase.body)
+
+			if testCase.expectedError == "" {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+
+				return
+			}
+
+			for _, err := range errs {
+				if strings.Contains(err.Error(), testCase.expectedError) {
+					return
+				}
+			}
+
+			t.Errorf("expected error containing %q, got %v", testCase.expectedError, errs)
+		})
+	}
+}
+
+func TestMetricMathReferences(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression string
+		expected   []string
+	}{
+		"arithmetic":   {"(m1 + m2) / 2", []string{"m1", "m2"}},
+		"functions":    {"FILL(m1, REPEAT) + ANOMALY_DETECTION_BAND(m1, 2)", []string{"m1"}},
+		"numbers":      {"m1 * 1e3 + 0x", []string{"m1"}},
+		"strings":      {"METRICS('errors') + SEARCH('{AWS/Lambda} m9', 'Sum')", nil},
+		"conditional":  {"IF(m1 > 0 AND m2 > 0, m1 / m2, 0)", []string{"m1", "m2"}},
+		"insights sql": {"SELECT MAX(latency) FROM SCHEMA(\"Custom\", host)", nil},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := metricMathReferences(testCase.expression); !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestLayoutDashboardWidgets(t *testing.T) {
+	t.Parallel()
+
+	widgets := []*dashboardWidget{
+		{Width: 12, Height: 6},
+		{Width: 6, Height: 3},
+		{Width: 12, Height: 6},
+		{Width: 24, Height: 2},
+		{Width: 8, Height: 4},
+	}
+
+	layoutDashboardWidgets(widgets)
+
+	expected := [][2]int{{0, 0}, {12, 0}, {0, 6}, {0, 12}, {0, 14}}
+
+	for i, widget := range widgets {
+		if got := [2]int{widget.X, widget.Y}; got != expected[i] {
+			t.Errorf("widget %d: expected position %v, got %v", i, expected[i], got)
+		}
+	}
+}
@@ -0,0 +1,591 @@
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document")
+func DataSourceDashboardDocument() *schema.Resource {
+	metricSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"color": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(metricColorRegexp, "must be a six-digit hex color, for example #1f77b4"),
+					},
+					"dimensions": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"expression": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(metricIDRegexp, "must start with a lowercase letter and contain only letters, numbers and underscores"),
+					},
+					"label": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"metric_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"namespace": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"period": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validMetricPeriod,
+					},
+					"stat": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validMetricStat,
+					},
+					"visible": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"y_axis": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(yAxis_Values(), false),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dashboardPeriodOverride_Values(), false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: dashboardMaxWidgets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_status": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: dashboardMaxWidgetAlarms,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(alarmWidgetSortBy_Values(), false),
+									},
+									"states": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardDefaultWidgetSize,
+							ValidateFunc: validation.IntBetween(1, dashboardMaxWidgetHeight),
+						},
+						"log_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: dashboardMaxLogGroups,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(logWidgetView_Values(), false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": metricSchema(),
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validMetricPeriod,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validMetricStat,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											metricWidgetViewBar,
+											metricWidgetViewPie,
+											metricWidgetViewTimeSeries,
+										}, false),
+									},
+								},
+							},
+						},
+						"single_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": metricSchema(),
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validMetricPeriod,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sparkline": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validMetricStat,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(textWidgetBackground_Values(), false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardDefaultWidgetSize,
+							ValidateFunc: validation.IntBetween(1, dashboardGridColumns),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &dashboardBody{
+		Start:          d.Get("start").(string),
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Widgets:        make([]*dashboardWidget, 0),
+	}
+
+	region := meta.(*conns.AWSClient).Region
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, region)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building CloudWatch Dashboard document: widget[%d]: %s", i, err)
+		}
+
+		doc.Widgets = append(doc.Widgets, widget)
+	}
+
+	layoutDashboardWidgets(doc.Widgets)
+
+	output, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building CloudWatch Dashboard document: %s", err)
+	}
+
+	jsonString := string(output)
+
+	if errs := validateDashboardBody(jsonString); len(errs) > 0 {
+		for _, err := range errs {
+			diags = sdkdiag.AppendErrorf(diags, "building CloudWatch Dashboard document: %s", err)
+		}
+
+		return diags
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+type dashboardBody struct {
+	Start          string             `json:"start,omitempty"`
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string                 `json:"type"`
+	X          int                    `json:"x"`
+	Y          int                    `json:"y"`
+	Width      int                    `json:"width"`
+	Height     int                    `json:"height"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// layoutDashboardWidgets places widgets on the dashboard grid in order, left to right.
+// A widget that does not fit in the remainder of a row starts a new row below the
+// tallest widget of the previous row.
+func layoutDashboardWidgets(widgets []*dashboardWidget) {
+	x, y, rowHeight := 0, 0, 0
+
+	for _, widget := range widgets {
+		if x > 0 && x+widget.Width > dashboardGridColumns {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		widget.X, widget.Y = x, y
+		x += widget.Width
+
+		if widget.Height > rowHeight {
+			rowHeight = widget.Height
+		}
+	}
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*dashboardWidget, error) {
+	widget := &dashboardWidget{
+		Width:      tfMap["width"].(int),
+		Height:     tfMap["height"].(int),
+		Properties: make(map[string]interface{}),
+	}
+
+	var kinds []string
+
+	for _, kind := range []string{"alarm_status", "log_query", "metric", "single_value", "text"} {
+		if v, ok := tfMap[kind].([]interface{}); ok && len(v) > 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	if len(kinds) != 1 {
+		return nil, errors.New("exactly one of alarm_status, log_query, metric, single_value or text must be specified")
+	}
+
+	kind := kinds[0]
+	tfMap, ok := tfMap[kind].([]interface{})[0].(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("%s: configuration block is empty", kind)
+	}
+
+	properties := widget.Properties
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	switch kind {
+	case "alarm_status":
+		widget.Type = widgetTypeAlarm
+		properties["alarms"] = flex.ExpandStringValueList(tfMap["alarms"].([]interface{}))
+
+		if v := tfMap["sort_by"].(string); v != "" {
+			properties["sortBy"] = v
+		}
+
+		if v := tfMap["states"].([]interface{}); len(v) > 0 {
+			properties["states"] = flex.ExpandStringValueList(v)
+		}
+
+	case "log_query":
+		widget.Type = widgetTypeLog
+		properties["region"] = dashboardWidgetRegion(tfMap, region)
+
+		var sources []string
+
+		for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+			sources = append(sources, fmt.Sprintf("SOURCE '%s'", v))
+		}
+
+		properties["query"] = strings.Join(append(sources, strings.TrimSpace(tfMap["query"].(string))), " | ")
+
+		if v := tfMap["stacked"].(bool); v {
+			properties["stacked"] = v
+		}
+
+		if v := tfMap["view"].(string); v != "" {
+			properties["view"] = v
+		}
+
+	case "metric", "single_value":
+		widget.Type = widgetTypeMetric
+		properties["region"] = dashboardWidgetRegion(tfMap, region)
+
+		metrics, err := expandDashboardMetrics(tfMap["metric"].([]interface{}))
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", kind, err)
+		}
+
+		properties["metrics"] = metrics
+
+		if v := tfMap["period"].(int); v != 0 {
+			properties["period"] = v
+		}
+
+		if v := tfMap["stat"].(string); v != "" {
+			properties["stat"] = v
+		}
+
+		if kind == "single_value" {
+			properties["view"] = metricWidgetViewSingleValue
+
+			if v := tfMap["sparkline"].(bool); v {
+				properties["sparkline"] = v
+			}
+		} else {
+			properties["view"] = metricWidgetViewTimeSeries
+
+			if v := tfMap["view"].(string); v != "" {
+				properties["view"] = v
+			}
+
+			if v := tfMap["stacked"].(bool); v {
+				properties["stacked"] = v
+			}
+		}
+
+	case "text":
+		widget.Type = widgetTypeText
+		properties["markdown"] = tfMap["markdown"].(string)
+
+		if v := tfMap["background"].(string); v != "" {
+			properties["background"] = v
+		}
+	}
+
+	return widget, nil
+}
+
+func dashboardWidgetRegion(tfMap map[string]interface{}, region string) string {
+	if v := tfMap["region"].(string); v != "" {
+		return v
+	}
+
+	return region
+}
+
+// expandDashboardMetrics returns the rows of a metric widget's metrics array. A metric is
+// [namespace, metric name, dimension name, dimension value, ..., options], and a math
+// expression is [options] with the expression in the options.
+func expandDashboardMetrics(tfList []interface{}) ([]interface{}, error) {
+	var metrics []interface{}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		options := make(map[string]interface{})
+
+		for _, field := range []struct {
+			name, key string
+		}{
+			{"color", "color"},
+			{"id", "id"},
+			{"label", "label"},
+			{"stat", "stat"},
+			{"y_axis", "yAxis"},
+		} {
+			if v := tfMap[field.name].(string); v != "" {
+				options[field.key] = v
+			}
+		}
+
+		if v := tfMap["period"].(int); v != 0 {
+			options["period"] = v
+		}
+
+		if v := tfMap["visible"].(bool); !v {
+			options["visible"] = v
+		}
+
+		namespace, metricName := tfMap["namespace"].(string), tfMap["metric_name"].(string)
+		dimensions := tfMap["dimensions"].(map[string]interface{})
+
+		if expression := tfMap["expression"].(string); expression != "" {
+			if namespace != "" || metricName != "" || len(dimensions) > 0 {
+				return nil, fmt.Errorf("metric[%d]: expression cannot be specified with namespace, metric_name or dimensions", i)
+			}
+
+			if _, ok := options["id"]; !ok {
+				return nil, fmt.Errorf("metric[%d]: id is required for math expressions", i)
+			}
+
+			options["expression"] = expression
+			metrics = append(metrics, []interface{}{options})
+
+			continue
+		}
+
+		if namespace == "" || metricName == "" {
+			return nil, fmt.Errorf("metric[%d]: either expression, or namespace and metric_name must be specified", i)
+		}
+
+		row := []interface{}{namespace, metricName}
+
+		names := make([]string, 0, len(dimensions))
+
+		for name := range dimensions {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			row = append(row, name, dimensions[name].(string))
+		}
+
+		if len(options) > 0 {
+			row = append(row, options)
+		}
+
+		metrics = append(metrics, row)
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,214 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", fmt.Sprintf(testAccDashboardDocumentDataSourceConfig_basic_ExpectedJSON, acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_body"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidExpression,
+				ExpectError: regexp.MustCompile(`references unknown metric id "m2"`),
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  start = "-PT6H"
+
+  widget {
+    width = 12
+
+    metric {
+      title = "CPU"
+      stat  = "Average"
+
+      metric {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        dimensions = {
+          InstanceId = "i-1234567890abcdef0"
+        }
+        visible = false
+      }
+
+      metric {
+        id         = "e1"
+        expression = "m1 * 2"
+        label      = "Doubled"
+      }
+    }
+  }
+
+  widget {
+    single_value {
+      sparkline = true
+
+      metric {
+        namespace   = "AWS/SQS"
+        metric_name = "NumberOfMessagesSent"
+        dimensions = {
+          QueueName = "test"
+        }
+      }
+    }
+  }
+
+  widget {
+    width  = 12
+    height = 4
+
+    log_query {
+      log_group_names = ["test"]
+      query           = "fields @timestamp, @message | limit 20"
+    }
+  }
+
+  widget {
+    text {
+      markdown = "# Test"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_basic_ExpectedJSON = `{
+  "start": "-PT6H",
+  "widgets": [
+    {
+      "type": "metric", "x": 0, "y": 0, "width": 12, "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "InstanceId", "i-1234567890abcdef0", {"id": "m1", "visible": false}],
+          [{"expression": "m1 * 2", "id": "e1", "label": "Doubled"}]
+        ],
+        "region": %[1]q,
+        "stat": "Average",
+        "title": "CPU",
+        "view": "timeSeries"
+      }
+    },
+    {
+      "type": "metric", "x": 12, "y": 0, "width": 6, "height": 6,
+      "properties": {
+        "metrics": [["AWS/SQS", "NumberOfMessagesSent", "QueueName", "test"]],
+        "region": %[1]q,
+        "sparkline": true,
+        "view": "singleValue"
+      }
+    },
+    {
+      "type": "log", "x": 0, "y": 6, "width": 12, "height": 4,
+      "properties": {
+        "query": "SOURCE 'test' | fields @timestamp, @message | limit 20",
+        "region": %[1]q
+      }
+    },
+    {
+      "type": "text", "x": 12, "y": 6, "width": 6, "height": 6,
+      "properties": {"markdown": "# Test"}
+    }
+  ]
+}`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      title = "EC2 Instance CPU"
+
+      metric {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        dimensions = {
+          InstanceId = "i-012345"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
+
+const testAccDashboardDocumentDataSourceConfig_invalidExpression = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      metric {
+        id         = "e1"
+        expression = "m1 + m2"
+      }
+    }
+  }
+}
+`
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -128,7 +129,7 @@ func resourceDomainPermissionsPolicyRead(ctx context.Context, d *schema.Resource
 	d.Set("resource_arn", dm.Policy.ResourceArn)
 	d.Set("policy_revision", dm.Policy.Revision)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), aws.StringValue(dm.Policy.Document))
+	policyToSet, err := tfpolicy.SecondUnlessEquivalent(d.Get("policy_document").(string), aws.StringValue(dm.Policy.Document))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
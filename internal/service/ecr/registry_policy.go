@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -90,7 +91,7 @@ func resourceRegistryPolicyRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("registry_id", out.RegistryId)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(out.PolicyText))
+	policyToSet, err := tfpolicy.SecondUnlessEquivalent(d.Get("policy").(string), aws.StringValue(out.PolicyText))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECR Registry Policy (%s): setting policy: %s", d.Id(), err)
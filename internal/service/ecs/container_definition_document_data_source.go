@@ -0,0 +1,549 @@
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_ecs_container_definition_document")
+func DataSourceContainerDefinitionDocument() *schema.Resource {
+	secretSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value_from": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerDefinitionDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cpu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"depends_on": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+									},
+									"container_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"docker_labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"entry_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"interval": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      healthCheckDefaultInterval,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      healthCheckDefaultRetries,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"start_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 300),
+									},
+									"timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      healthCheckDefaultTimeout,
+										ValidateFunc: validation.IntBetween(2, 60),
+									},
+								},
+							},
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_option": secretSchema(),
+								},
+							},
+						},
+						"memory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(6),
+						},
+						"memory_reservation": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(6),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port_mapping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+									},
+									"container_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"host_port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      ecs.TransportProtocolTcp,
+										ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+									},
+								},
+							},
+						},
+						"secret": secretSchema(),
+						"ulimit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hard_limit": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+									},
+									"soft_limit": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"working_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerDefinitionDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var definitions []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range d.Get("container").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		definitions = append(definitions, expandContainerDefinitionDocumentContainer(tfMap))
+	}
+
+	if errs := validateContainerDefinitions(definitions); len(errs) > 0 {
+		for _, err := range errs {
+			diags = sdkdiag.AppendErrorf(diags, "building ECS Container Definition document: %s", err)
+		}
+
+		return diags
+	}
+
+	jsonString, err := flattenContainerDefinitions(definitions)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building ECS Container Definition document: %s", err)
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandContainerDefinitionDocumentContainer(tfMap map[string]interface{}) *ecs.ContainerDefinition {
+	apiObject := &ecs.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.DependsOn = append(apiObject.DependsOn, &ecs.ContainerDependency{
+				Condition:     aws.String(tfMap["condition"].(string)),
+				ContainerName: aws.String(tfMap["container_name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DockerLabels = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		names := make([]string, 0, len(v))
+
+		for name := range v {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+				Name:  aws.String(name),
+				Value: aws.String(v[name].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.HealthCheck = &ecs.HealthCheck{
+			Command:  flex.ExpandStringList(tfMap["command"].([]interface{})),
+			Interval: aws.Int64(int64(tfMap["interval"].(int))),
+			Retries:  aws.Int64(int64(tfMap["retries"].(int))),
+			Timeout:  aws.Int64(int64(tfMap["timeout"].(int))),
+		}
+
+		if v := tfMap["start_period"].(int); v != 0 {
+			apiObject.HealthCheck.StartPeriod = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LogConfiguration = &ecs.LogConfiguration{
+			LogDriver:     aws.String(tfMap["log_driver"].(string)),
+			SecretOptions: expandContainerDefinitionDocumentSecrets(tfMap["secret_option"].([]interface{})),
+		}
+
+		if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.LogConfiguration.Options = flex.ExpandStringMap(v)
+		}
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			portMapping := &ecs.PortMapping{
+				ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+				Protocol:      aws.String(tfMap["protocol"].(string)),
+			}
+
+			if v := tfMap["app_protocol"].(string); v != "" {
+				portMapping.AppProtocol = aws.String(v)
+			}
+
+			if v := tfMap["host_port"].(int); v != 0 {
+				portMapping.HostPort = aws.Int64(int64(v))
+			}
+
+			if v := tfMap["name"].(string); v != "" {
+				portMapping.Name = aws.String(v)
+			}
+
+			apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+		}
+	}
+
+	apiObject.Secrets = expandContainerDefinitionDocumentSecrets(tfMap["secret"].([]interface{}))
+
+	if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Ulimits = append(apiObject.Ulimits, &ecs.Ulimit{
+				HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+				Name:      aws.String(tfMap["name"].(string)),
+				SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+			})
+		}
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionDocumentSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// validateContainerDefinitions checks the constraints that ECS applies across the
+// fields and containers of a task definition's container definitions.
+func validateContainerDefinitions(definitions []*ecs.ContainerDefinition) []error {
+	var errs []error
+
+	names := make(map[string]struct{})
+	essential := false
+
+	for _, def := range definitions {
+		name := aws.StringValue(def.Name)
+
+		if _, ok := names[name]; ok {
+			errs = append(errs, fmt.Errorf("duplicate container name %q", name))
+		}
+
+		names[name] = struct{}{}
+
+		if aws.BoolValue(def.Essential) {
+			essential = true
+		}
+	}
+
+	if !essential {
+		errs = append(errs, errors.New("at least one container must be essential"))
+	}
+
+	for i, def := range definitions {
+		path := fmt.Sprintf("container[%d] (%s)", i, aws.StringValue(def.Name))
+
+		if def.Memory != nil && def.MemoryReservation != nil && aws.Int64Value(def.MemoryReservation) > aws.Int64Value(def.Memory) {
+			errs = append(errs, fmt.Errorf("%s: memory_reservation (%d) must not be greater than memory (%d)", path, aws.Int64Value(def.MemoryReservation), aws.Int64Value(def.Memory)))
+		}
+
+		for _, dep := range def.DependsOn {
+			switch name := aws.StringValue(dep.ContainerName); {
+			case name == aws.StringValue(def.Name):
+				errs = append(errs, fmt.Errorf("%s: depends_on: container cannot depend on itself", path))
+			default:
+				if _, ok := names[name]; !ok {
+					errs = append(errs, fmt.Errorf("%s: depends_on: container %q does not exist", path, name))
+				}
+			}
+		}
+
+		if hc := def.HealthCheck; hc != nil {
+			switch command := aws.StringValue(hc.Command[0]); command {
+			case "CMD", "CMD-SHELL", "NONE":
+			default:
+				errs = append(errs, fmt.Errorf("%s: health_check: command must start with CMD, CMD-SHELL or NONE, got: %q", path, command))
+			}
+		}
+
+		ports := make(map[string]struct{})
+
+		for _, pm := range def.PortMappings {
+			key := fmt.Sprintf("%d/%s", aws.Int64Value(pm.ContainerPort), aws.StringValue(pm.Protocol))
+
+			if _, ok := ports[key]; ok {
+				errs = append(errs, fmt.Errorf("%s: port_mapping: duplicate container port %s", path, key))
+			}
+
+			ports[key] = struct{}{}
+		}
+
+		secrets := make(map[string]struct{})
+
+		for _, env := range def.Environment {
+			secrets[aws.StringValue(env.Name)] = struct{}{}
+		}
+
+		for _, secret := range def.Secrets {
+			name := aws.StringValue(secret.Name)
+
+			if _, ok := secrets[name]; ok {
+				errs = append(errs, fmt.Errorf("%s: secret: %q is already set as an environment variable or secret", path, name))
+			}
+
+			secrets[name] = struct{}{}
+		}
+
+		ulimits := make(map[string]struct{})
+
+		for _, ulimit := range def.Ulimits {
+			name := aws.StringValue(ulimit.Name)
+
+			if _, ok := ulimits[name]; ok {
+				errs = append(errs, fmt.Errorf("%s: ulimit: duplicate ulimit %q", path, name))
+			}
+
+			ulimits[name] = struct{}{}
+
+			if aws.Int64Value(ulimit.SoftLimit) > aws.Int64Value(ulimit.HardLimit) {
+				errs = append(errs, fmt.Errorf("%s: ulimit %q: soft_limit (%d) must not be greater than hard_limit (%d)", path, name, aws.Int64Value(ulimit.SoftLimit), aws.Int64Value(ulimit.HardLimit)))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,194 @@
+package ecs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSContainerDefinitionDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccContainerDefinitionDocumentDataSourceConfig_basic_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionDocumentDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionDocumentDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionDocumentDataSource_invalidDependsOn(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerDefinitionDocumentDataSourceConfig_invalidDependsOn,
+				ExpectError: regexp.MustCompile(`depends_on: container "init" does not exist`),
+			},
+		},
+	})
+}
+
+const testAccContainerDefinitionDocumentDataSourceConfig_basic = `
+data "aws_ecs_container_definition_document" "test" {
+  container {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 256
+    memory = 512
+
+    environment = {
+      B_VARIABLE = "b"
+      A_VARIABLE = "a"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    secret {
+      name       = "PASSWORD"
+      value_from = "/web/password"
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+      options = {
+        awslogs-group = "web"
+      }
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    depends_on {
+      container_name = "init"
+      condition      = "SUCCESS"
+    }
+
+    ulimit {
+      name       = "nofile"
+      soft_limit = 4096
+      hard_limit = 8192
+    }
+  }
+
+  container {
+    name      = "init"
+    image     = "busybox:latest"
+    essential = false
+    command   = ["true"]
+  }
+}
+`
+
+const testAccContainerDefinitionDocumentDataSourceConfig_basic_ExpectedJSON = `[
+  {
+    "name": "web",
+    "image": "nginx:latest",
+    "cpu": 256,
+    "memory": 512,
+    "essential": true,
+    "environment": [
+      {"name": "A_VARIABLE", "value": "a"},
+      {"name": "B_VARIABLE", "value": "b"}
+    ],
+    "portMappings": [{"containerPort": 80, "protocol": "tcp"}],
+    "secrets": [{"name": "PASSWORD", "valueFrom": "/web/password"}],
+    "logConfiguration": {"logDriver": "awslogs", "options": {"awslogs-group": "web"}},
+    "healthCheck": {
+      "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+      "interval": 30,
+      "retries": 3,
+      "timeout": 5
+    },
+    "dependsOn": [{"containerName": "init", "condition": "SUCCESS"}],
+    "ulimits": [{"name": "nofile", "softLimit": 4096, "hardLimit": 8192}]
+  },
+  {
+    "name": "init",
+    "image": "busybox:latest",
+    "essential": false,
+    "command": ["true"]
+  }
+]`
+
+func testAccContainerDefinitionDocumentDataSourceConfig_taskDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_container_definition_document" "test" {
+  container {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 10
+    memory = 128
+
+    port_mapping {
+      container_port = 80
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                = %[1]q
+  container_definitions = data.aws_ecs_container_definition_document.test.json
+}
+`, rName)
+}
+
+const testAccContainerDefinitionDocumentDataSourceConfig_invalidDependsOn = `
+data "aws_ecs_container_definition_document" "test" {
+  container {
+    name  = "web"
+    image = "nginx:latest"
+
+    depends_on {
+      container_name = "init"
+      condition      = "SUCCESS"
+    }
+  }
+}
+`
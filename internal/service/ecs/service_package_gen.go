@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContainerDefinition,
 			TypeName: "aws_ecs_container_definition",
 		},
+		{
+			Factory:  DataSourceContainerDefinitionDocument,
+			TypeName: "aws_ecs_container_definition_document",
+		},
 		{
 			Factory:  DataSourceService,
 			TypeName: "aws_ecs_service",
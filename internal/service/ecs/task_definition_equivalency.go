@@ -53,11 +53,20 @@ func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	return equal, nil
 }
 
+// Defaults set by ECS for container health checks.
+// https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_HealthCheck.html.
+const (
+	healthCheckDefaultInterval = 30
+	healthCheckDefaultRetries  = 3
+	healthCheckDefaultTimeout  = 5
+)
+
 type containerDefinitions []*ecs.ContainerDefinition
 
 func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 	// Deal with fields which may be re-ordered in the API
 	cd.OrderEnvironmentVariables()
+	cd.orderUnorderedLists()
 
 	for i, def := range cd {
 		// Deal with special fields which have defaults
@@ -68,7 +77,7 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 			def.Essential = aws.Bool(true)
 		}
 		for j, pm := range def.PortMappings {
-			if pm.Protocol != nil && aws.StringValue(pm.Protocol) == "tcp" {
+			if pm.Protocol != nil && aws.StringValue(pm.Protocol) == ecs.TransportProtocolTcp {
 				cd[i].PortMappings[j].Protocol = nil
 			}
 			if pm.HostPort != nil && aws.Int64Value(pm.HostPort) == 0 {
@@ -78,6 +87,20 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 				cd[i].PortMappings[j].HostPort = cd[i].PortMappings[j].ContainerPort
 			}
 		}
+		if hc := def.HealthCheck; hc != nil {
+			if hc.Interval == nil {
+				hc.Interval = aws.Int64(healthCheckDefaultInterval)
+			}
+			if hc.Retries == nil {
+				hc.Retries = aws.Int64(healthCheckDefaultRetries)
+			}
+			if hc.Timeout == nil {
+				hc.Timeout = aws.Int64(healthCheckDefaultTimeout)
+			}
+			if hc.StartPeriod != nil && aws.Int64Value(hc.StartPeriod) == 0 {
+				hc.StartPeriod = nil
+			}
+		}
 
 		// Create a mutable copy
 		defCopy, err := copystructure.Copy(def)
@@ -86,16 +109,8 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 		}
 
 		definition := reflect.ValueOf(defCopy).Elem()
-		for i := 0; i < definition.NumField(); i++ {
-			sf := definition.Field(i)
+		reduceEmptyValues(definition)
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
-				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
-					sf.Set(reflect.Zero(sf.Type()))
-				}
-			}
-		}
 		iface := definition.Interface().(ecs.ContainerDefinition)
 		cd[i] = &iface
 	}
@@ -109,3 +124,79 @@ func (cd containerDefinitions) OrderEnvironmentVariables() {
 		})
 	}
 }
+
+// orderUnorderedLists sorts the lists whose order has no meaning to ECS,
+// so that reordering them in configuration does not replace the task definition.
+func (cd containerDefinitions) orderUnorderedLists() {
+	for _, def := range cd {
+		sort.SliceStable(def.Secrets, func(i, j int) bool {
+			return aws.StringValue(def.Secrets[i].Name) < aws.StringValue(def.Secrets[j].Name)
+		})
+		sort.SliceStable(def.DependsOn, func(i, j int) bool {
+			return aws.StringValue(def.DependsOn[i].ContainerName) < aws.StringValue(def.DependsOn[j].ContainerName)
+		})
+		sort.SliceStable(def.Ulimits, func(i, j int) bool {
+			return aws.StringValue(def.Ulimits[i].Name) < aws.StringValue(def.Ulimits[j].Name)
+		})
+		sort.SliceStable(def.SystemControls, func(i, j int) bool {
+			return aws.StringValue(def.SystemControls[i].Namespace) < aws.StringValue(def.SystemControls[j].Namespace)
+		})
+		if lc := def.LogConfiguration; lc != nil {
+			sort.SliceStable(lc.SecretOptions, func(i, j int) bool {
+				return aws.StringValue(lc.SecretOptions[i].Name) < aws.StringValue(lc.SecretOptions[j].Name)
+			})
+		}
+	}
+}
+
+// reduceEmptyValues sets empty lists, maps and structures, and false booleans other than
+// essential (which defaults to true), to nil in a container definition. ECS treats these
+// the same as omitted fields, and may return either.
+// It returns whether every field of the structure is nil.
+func reduceEmptyValues(v reflect.Value) bool {
+	empty := true
+
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Field(i)
+
+		if !sf.CanSet() {
+			continue
+		}
+
+		switch sf.Kind() {
+		case reflect.Slice:
+			for j := 0; j < sf.Len(); j++ {
+				if e := sf.Index(j); e.Kind() == reflect.Ptr && !e.IsNil() && e.Elem().Kind() == reflect.Struct {
+					reduceEmptyValues(e.Elem())
+				}
+			}
+			if !sf.IsNil() && sf.Len() == 0 {
+				sf.Set(reflect.Zero(sf.Type()))
+			}
+		case reflect.Map:
+			if !sf.IsNil() && sf.Len() == 0 {
+				sf.Set(reflect.Zero(sf.Type()))
+			}
+		case reflect.Ptr:
+			if sf.IsNil() {
+				break
+			}
+			switch e := sf.Elem(); e.Kind() {
+			case reflect.Bool:
+				if !e.Bool() && v.Type().Field(i).Name != "Essential" {
+					sf.Set(reflect.Zero(sf.Type()))
+				}
+			case reflect.Struct:
+				if reduceEmptyValues(e) {
+					sf.Set(reflect.Zero(sf.Type()))
+				}
+			}
+		}
+
+		if !sf.IsZero() {
+			empty = false
+		}
+	}
+
+	return empty
+}
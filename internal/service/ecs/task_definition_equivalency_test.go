@@ -575,3 +575,205 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalent_healthCheck(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "healthCheck": {
+        "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+        "startPeriod": 0
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "essential": true,
+        "healthCheck": {
+            "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+            "interval": 30,
+            "timeout": 5,
+            "retries": 3
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+
+	cfgRepresention = `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "healthCheck": {
+        "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+        "interval": 10
+      }
+    }
+]`
+
+	equal, err = tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_serverDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "privileged": false,
+      "readonlyRootFilesystem": false,
+      "dockerLabels": {},
+      "mountPoints": [
+        {"sourceVolume": "data", "containerPath": "/data", "readOnly": false}
+      ],
+      "linuxParameters": {
+        "capabilities": {"add": [], "drop": []},
+        "initProcessEnabled": true
+      },
+      "logConfiguration": {
+        "logDriver": "awslogs",
+        "options": {"awslogs-group": "wordpress"},
+        "secretOptions": []
+      },
+      "repositoryCredentials": {}
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "cpu": 0,
+        "essential": true,
+        "environment": [],
+        "environmentFiles": [],
+        "mountPoints": [
+            {"sourceVolume": "data", "containerPath": "/data"}
+        ],
+        "volumesFrom": [],
+        "systemControls": [],
+        "linuxParameters": {
+            "initProcessEnabled": true
+        },
+        "logConfiguration": {
+            "logDriver": "awslogs",
+            "options": {"awslogs-group": "wordpress"}
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_essentialFalse(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "essential": false
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "essential": true
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_unorderedLists(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "secrets": [
+        {"name": "PASSWORD", "valueFrom": "/wordpress/password"},
+        {"name": "API_KEY", "valueFrom": "/wordpress/api-key"}
+      ],
+      "dependsOn": [
+        {"containerName": "mysql", "condition": "HEALTHY"},
+        {"containerName": "init", "condition": "SUCCESS"}
+      ],
+      "ulimits": [
+        {"name": "nproc", "softLimit": 1024, "hardLimit": 2048},
+        {"name": "nofile", "softLimit": 4096, "hardLimit": 8192}
+      ]
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "essential": true,
+        "secrets": [
+            {"name": "API_KEY", "valueFrom": "/wordpress/api-key"},
+            {"name": "PASSWORD", "valueFrom": "/wordpress/password"}
+        ],
+        "dependsOn": [
+            {"containerName": "init", "condition": "SUCCESS"},
+            {"containerName": "mysql", "condition": "HEALTHY"}
+        ],
+        "ulimits": [
+            {"name": "nofile", "softLimit": 4096, "hardLimit": 8192},
+            {"name": "nproc", "softLimit": 1024, "hardLimit": 2048}
+        ]
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
@@ -0,0 +1,613 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+)
+
+// Offline matching of events against EventBridge event patterns.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+
+const (
+	patternOperatorAnythingBut      = "anything-but"
+	patternOperatorCIDR             = "cidr"
+	patternOperatorEqualsIgnoreCase = "equals-ignore-case"
+	patternOperatorExists           = "exists"
+	patternOperatorNumeric          = "numeric"
+	patternOperatorPrefix           = "prefix"
+	patternOperatorSuffix           = "suffix"
+	patternOperatorWildcard         = "wildcard"
+
+	patternKeyOr = "$or"
+)
+
+func patternOperator_Values() []string {
+	return []string{
+		patternOperatorAnythingBut,
+		patternOperatorCIDR,
+		patternOperatorEqualsIgnoreCase,
+		patternOperatorExists,
+		patternOperatorNumeric,
+		patternOperatorPrefix,
+		patternOperatorSuffix,
+		patternOperatorWildcard,
+	}
+}
+
+const (
+	// Numeric matching only works for values in this range.
+	patternNumericMin = -5.0e9
+	patternNumericMax = 5.0e9
+)
+
+// eventPattern is a compiled EventBridge event pattern.
+// An event matches if every field matches and, if there are any, at least one of the $or patterns matches.
+type eventPattern struct {
+	fields map[string]*eventPatternField
+	or     []*eventPattern
+}
+
+// eventPatternField matches a field either against a nested pattern or against any of a list of values.
+type eventPatternField struct {
+	nested   *eventPattern
+	matchers []eventValueMatcher
+}
+
+// eventValueMatcher reports whether an event value matches.
+// present is false if the field is not in the event.
+type eventValueMatcher func(value interface{}, present bool) bool
+
+// compileEventPattern parses an event pattern.
+func compileEventPattern(pattern string) (*eventPattern, error) {
+	v, err := decodeEventJSON(pattern)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil, errors.New("event pattern must be a JSON object")
+	}
+
+	if len(m) == 0 {
+		return nil, errors.New("event pattern must not be empty")
+	}
+
+	return compileEventPatternObject("", m)
+}
+
+// decodeEventJSON decodes an event pattern or event, keeping numbers as json.Number.
+func decodeEventJSON(s string) (interface{}, error) {
+	var v interface{}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return v, nil
+}
+
+func joinPatternPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func compileEventPatternObject(path string, m map[string]interface{}) (*eventPattern, error) {
+	p := &eventPattern{
+		fields: make(map[string]*eventPatternField),
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := joinPatternPath(path, k)
+
+		if k == patternKeyOr {
+			list, ok := m[k].([]interface{})
+
+			if !ok || len(list) < 2 {
+				return nil, fmt.Errorf("%s: must be an array of at least two event patterns", fieldPath)
+			}
+
+			for i, v := range list {
+				m, ok := v.(map[string]interface{})
+
+				if !ok || len(m) == 0 {
+					return nil, fmt.Errorf("%s[%d]: must be a non-empty JSON object", fieldPath, i)
+				}
+
+				or, err := compileEventPatternObject(path, m)
+
+				if err != nil {
+					return nil, err
+				}
+
+				p.or = append(p.or, or)
+			}
+
+			continue
+		}
+
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must not be empty", fieldPath)
+			}
+
+			nested, err := compileEventPatternObject(fieldPath, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			p.fields[k] = &eventPatternField{nested: nested}
+		case []interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must contain at least one value", fieldPath)
+			}
+
+			field := &eventPatternField{}
+
+			for i, v := range v {
+				matcher, err := compileEventValueMatcher(fmt.Sprintf("%s[%d]", fieldPath, i), v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				field.matchers = append(field.matchers, matcher)
+			}
+
+			p.fields[k] = field
+		default:
+			return nil, fmt.Errorf("%s: must be a JSON object or array", fieldPath)
+		}
+	}
+
+	return p, nil
+}
+
+func compileEventValueMatcher(path string, v interface{}) (eventValueMatcher, error) {
+	switch v := v.(type) {
+	case nil, bool, json.Number, string:
+		return exactMatcher(v), nil
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("%s: must contain exactly one operator", path)
+		}
+
+		for operator, operand := range v {
+			return compileEventOperator(joinPatternPath(path, operator), operator, operand)
+		}
+	}
+
+	return nil, fmt.Errorf("%s: must be a string, number, boolean, null or operator object", path)
+}
+
+func compileEventOperator(path, operator string, operand interface{}) (eventValueMatcher, error) {
+	switch operator {
+	case patternOperatorAnythingBut:
+		return compileAnythingBut(path, operand)
+	case patternOperatorCIDR:
+		s, ok := operand.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: must be a CIDR block string", path)
+		}
+
+		_, ipNet, err := net.ParseCIDR(s)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		return func(value interface{}, present bool) bool {
+			s, ok := value.(string)
+			if !ok {
+				return false
+			}
+
+			ip := net.ParseIP(s)
+
+			return ip != nil && ipNet.Contains(ip)
+		}, nil
+	case patternOperatorEqualsIgnoreCase:
+		s, ok := operand.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: must be a string", path)
+		}
+
+		return stringMatcher(func(v string) bool { return strings.EqualFold(v, s) }), nil
+	case patternOperatorExists:
+		exists, ok := operand.(bool)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: must be true or false", path)
+		}
+
+		return func(value interface{}, present bool) bool {
+			return present == exists
+		}, nil
+	case patternOperatorNumeric:
+		return compileNumeric(path, operand)
+	case patternOperatorPrefix, patternOperatorSuffix:
+		match := strings.HasPrefix
+		if operator == patternOperatorSuffix {
+			match = strings.HasSuffix
+		}
+
+		switch operand := operand.(type) {
+		case string:
+			return stringMatcher(func(v string) bool { return match(v, operand) }), nil
+		case map[string]interface{}:
+			if s, ok := operand[patternOperatorEqualsIgnoreCase].(string); ok && len(operand) == 1 {
+				s = strings.ToLower(s)
+				return stringMatcher(func(v string) bool { return match(strings.ToLower(v), s) }), nil
+			}
+		}
+
+		return nil, fmt.Errorf("%s: must be a string or an object containing %s", path, patternOperatorEqualsIgnoreCase)
+	case patternOperatorWildcard:
+		s, ok := operand.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: must be a string", path)
+		}
+
+		if err := validWildcard(s); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		return stringMatcher(func(v string) bool { return wildcardMatch(s, v) }), nil
+	}
+
+	return nil, fmt.Errorf("%s: unsupported operator %q, expected one of %s", path, operator, strings.Join(patternOperator_Values(), ", "))
+}
+
+func compileAnythingBut(path string, operand interface{}) (eventValueMatcher, error) {
+	var matchers []eventValueMatcher
+
+	switch operand := operand.(type) {
+	case json.Number, string:
+		matchers = append(matchers, exactMatcher(operand))
+	case []interface{}:
+		if len(operand) == 0 {
+			return nil, fmt.Errorf("%s: must contain at least one value", path)
+		}
+
+		for i, v := range operand {
+			switch v.(type) {
+			case json.Number, string:
+				matchers = append(matchers, exactMatcher(v))
+			default:
+				return nil, fmt.Errorf("%s[%d]: must be a string or number", path, i)
+			}
+		}
+	case map[string]interface{}:
+		if len(operand) != 1 {
+			return nil, fmt.Errorf("%s: must contain exactly one operator", path)
+		}
+
+		for operator, v := range operand {
+			switch operator {
+			case patternOperatorEqualsIgnoreCase, patternOperatorPrefix, patternOperatorSuffix, patternOperatorWildcard:
+			default:
+				return nil, fmt.Errorf("%s: unsupported operator %q, expected one of %s", joinPatternPath(path, operator), operator, strings.Join([]string{patternOperatorEqualsIgnoreCase, patternOperatorPrefix, patternOperatorSuffix, patternOperatorWildcard}, ", "))
+			}
+
+			values := []interface{}{v}
+			if list, ok := v.([]interface{}); ok && (operator == patternOperatorEqualsIgnoreCase || operator == patternOperatorWildcard) {
+				values = list
+			}
+
+			for _, v := range values {
+				if _, ok := v.(string); !ok {
+					return nil, fmt.Errorf("%s: must be a string", joinPatternPath(path, operator))
+				}
+
+				matcher, err := compileEventOperator(joinPatternPath(path, operator), operator, v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				matchers = append(matchers, matcher)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s: must be a string, number, array or operator object", path)
+	}
+
+	return func(value interface{}, present bool) bool {
+		if !present {
+			return false
+		}
+
+		for _, matcher := range matchers {
+			if matcher(value, present) {
+				return false
+			}
+		}
+
+		return true
+	}, nil
+}
+
+func compileNumeric(path string, operand interface{}) (eventValueMatcher, error) {
+	list, ok := operand.([]interface{})
+
+	if !ok || (len(list) != 2 && len(list) != 4) {
+		return nil, fmt.Errorf("%s: must be an array of one or two comparisons", path)
+	}
+
+	type comparison struct {
+		operator string
+		value    float64
+	}
+
+	var comparisons []comparison
+
+	for i := 0; i < len(list); i += 2 {
+		operator, ok := list[i].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("%s[%d]: must be a comparison operator", path, i)
+		}
+
+		switch operator {
+		case "<", "<=", "=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("%s[%d]: unsupported comparison operator %q", path, i, operator)
+		}
+
+		n, ok := list[i+1].(json.Number)
+
+		if !ok {
+			return nil, fmt.Errorf("%s[%d]: must be a number", path, i+1)
+		}
+
+		value, err := n.Float64()
+
+		if err != nil || value < patternNumericMin || value > patternNumericMax {
+			return nil, fmt.Errorf("%s[%d]: must be a number between %g and %g", path, i+1, patternNumericMin, patternNumericMax)
+		}
+
+		comparisons = append(comparisons, comparison{operator: operator, value: value})
+	}
+
+	if len(comparisons) == 2 {
+		lower, upper := comparisons[0], comparisons[1]
+
+		if (lower.operator != ">" && lower.operator != ">=") || (upper.operator != "<" && upper.operator != "<=") || lower.value >= upper.value {
+			return nil, fmt.Errorf("%s: a range must be a lower bound (> or >=) followed by a greater upper bound (< or <=)", path)
+		}
+	}
+
+	return func(value interface{}, present bool) bool {
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+
+		v, err := n.Float64()
+		if err != nil {
+			return false
+		}
+
+		for _, c := range comparisons {
+			var ok bool
+
+			switch c.operator {
+			case "<":
+				ok = v < c.value
+			case "<=":
+				ok = v <= c.value
+			case "=":
+				ok = v == c.value
+			case ">":
+				ok = v > c.value
+			case ">=":
+				ok = v >= c.value
+			}
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	}, nil
+}
+
+// exactMatcher matches values equal to the specified string, number, boolean or null.
+func exactMatcher(expected interface{}) eventValueMatcher {
+	return func(value interface{}, present bool) bool {
+		if !present {
+			return false
+		}
+
+		switch expected := expected.(type) {
+		case json.Number:
+			n, ok := value.(json.Number)
+			if !ok {
+				return false
+			}
+
+			v1, err1 := expected.Float64()
+			v2, err2 := n.Float64()
+
+			return err1 == nil && err2 == nil && v1 == v2
+		default:
+			return value == expected
+		}
+	}
+}
+
+func stringMatcher(f func(string) bool) eventValueMatcher {
+	return func(value interface{}, present bool) bool {
+		s, ok := value.(string)
+
+		return ok && f(s)
+	}
+}
+
+func validWildcard(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) || (pattern[i+1] != '*' && pattern[i+1] != '\\') {
+				return errors.New(`\ must be followed by * or \`)
+			}
+			i++
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				return errors.New("consecutive wildcard characters are not supported")
+			}
+		}
+	}
+
+	return nil
+}
+
+// wildcardMatch reports whether s matches pattern, in which * matches any sequence of characters and \ escapes * and \.
+func wildcardMatch(pattern, s string) bool {
+	var p, str int
+	star, match := -1, 0
+
+	for str < len(s) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, str
+			p++
+		case p < len(pattern) && pattern[p] == '\\' && p+1 < len(pattern) && pattern[p+1] == s[str]:
+			p += 2
+			str++
+		case p < len(pattern) && pattern[p] != '\\' && pattern[p] == s[str]:
+			p++
+			str++
+		case star != -1:
+			match++
+			p, str = star+1, match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// match reports whether an event matches the pattern.
+func (p *eventPattern) match(event map[string]interface{}) bool {
+	for name, field := range p.fields {
+		value, present := event[name]
+
+		if !field.match(value, present) {
+			return false
+		}
+	}
+
+	if len(p.or) == 0 {
+		return true
+	}
+
+	for _, or := range p.or {
+		if or.match(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *eventPatternField) match(value interface{}, present bool) bool {
+	if f.nested != nil {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			return f.nested.match(value)
+		case []interface{}:
+			// A pattern matches an array if it matches any element of the array.
+			for _, v := range value {
+				if v, ok := v.(map[string]interface{}); ok && f.nested.match(v) {
+					return true
+				}
+			}
+
+			return false
+		default:
+			return !present && f.nested.match(map[string]interface{}{})
+		}
+	}
+
+	values := []interface{}{value}
+
+	if list, ok := value.([]interface{}); ok {
+		values = list
+
+		if len(list) == 0 {
+			present = false
+		}
+	}
+
+	if !present {
+		for _, matcher := range f.matchers {
+			if matcher(nil, false) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, v := range values {
+		for _, matcher := range f.matchers {
+			if matcher(v, true) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchEvent reports whether the JSON event matches the pattern.
+func (p *eventPattern) matchEvent(event string) (bool, error) {
+	v, err := decodeEventJSON(event)
+
+	if err != nil {
+		return false, err
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return false, errors.New("event must be a JSON object")
+	}
+
+	return p.match(m), nil
+}
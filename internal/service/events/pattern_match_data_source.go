@@ -0,0 +1,93 @@
+package events
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_match")
+func DataSourcePatternMatch() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matched_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"matches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeBool,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePatternMatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	eventPattern, err := structure.NormalizeJsonString(d.Get("event_pattern").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing EventBridge event pattern: %s", err)
+	}
+
+	pattern, err := compileEventPattern(eventPattern)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing EventBridge event pattern: %s", err)
+	}
+
+	events := d.Get("events").([]interface{})
+	matchedEvents := make([]string, 0)
+	matches := make([]bool, 0, len(events))
+
+	for i, v := range events {
+		event, _ := v.(string)
+		match, err := pattern.matchEvent(event)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "matching EventBridge event pattern: events[%d]: %s", i, err)
+		}
+
+		if match {
+			matchedEvents = append(matchedEvents, event)
+		}
+		matches = append(matches, match)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(eventPattern + strings.Join(flex.ExpandStringValueList(events), ""))))
+	d.Set("matched_events", matchedEvents)
+	d.Set("matches", matches)
+
+	return diags
+}
@@ -0,0 +1,84 @@
+package events_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsPatternMatchDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_match.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternMatchDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.0", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.1", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.2", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_events.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternMatchDataSource_unsupportedOperator(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternMatchDataSourceConfig_unsupportedOperator,
+				ExpectError: regexp.MustCompile(`unsupported operator "contains"`),
+			},
+		},
+	})
+}
+
+const testAccPatternMatchDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ "anything-but" = "pending" }]
+      "$or" = [
+        { cpu = [{ numeric = [">", 80] }] },
+        { "instance-id" = [{ prefix = "i-0abc" }] },
+      ]
+    }
+  })
+
+  events = [
+    jsonencode({ source = "aws.ec2", detail = { state = "running", cpu = 95 } }),
+    jsonencode({ source = "aws.ec2", detail = { state = "pending", cpu = 95 } }),
+    jsonencode({ source = "aws.ec2", detail = { state = "stopped", cpu = 5, "instance-id" = "i-0abc123" } }),
+  ]
+}
+`
+
+const testAccPatternMatchDataSourceConfig_unsupportedOperator = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    detail = {
+      state = [{ contains = "run" }]
+    }
+  })
+
+  events = [
+    jsonencode({ detail = { state = "running" } }),
+  ]
+}
+`
@@ -0,0 +1,131 @@
+package events
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEventPatternMatch(t *testing.T) {
+	t.Parallel()
+
+	event := `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "account": "123456789012",
+  "detail": {
+    "state": "running",
+    "instance-id": "i-1234567890abcdef0",
+    "cpu": 85.5,
+    "tags": ["prod", "web"],
+    "source-ip": "10.0.0.15",
+    "flag": null,
+    "volumes": [{"size": 8, "type": "gp3"}, {"size": 100, "type": "io2"}]
+  }
+}`
+
+	testCases := map[string]struct {
+		pattern  string
+		expected bool
+	}{
+		"exact":                           {`{"source": ["aws.ec2"]}`, true},
+		"exact mismatch":                  {`{"source": ["aws.s3"]}`, false},
+		"exact any of":                    {`{"source": ["aws.s3", "aws.ec2"]}`, true},
+		"exact nested":                    {`{"detail": {"state": ["running"]}}`, true},
+		"exact number":                    {`{"detail": {"cpu": [85.50]}}`, true},
+		"exact number type":               {`{"detail": {"cpu": ["85.5"]}}`, false},
+		"exact null":                      {`{"detail": {"flag": [null]}}`, true},
+		"exact array":                     {`{"detail": {"tags": ["web"]}}`, true},
+		"all fields":                      {`{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`, false},
+		"missing field":                   {`{"detail": {"missing": ["x"]}}`, false},
+		"prefix":                          {`{"detail": {"instance-id": [{"prefix": "i-123"}]}}`, true},
+		"prefix mismatch":                 {`{"detail": {"instance-id": [{"prefix": "i-999"}]}}`, false},
+		"prefix equals-ignore-case":       {`{"detail-type": [{"prefix": {"equals-ignore-case": "ec2 INSTANCE"}}]}`, true},
+		"suffix":                          {`{"detail": {"instance-id": [{"suffix": "def0"}]}}`, true},
+		"suffix equals-ignore-case":       {`{"detail-type": [{"suffix": {"equals-ignore-case": "NOTIFICATION"}}]}`, true},
+		"anything-but":                    {`{"detail": {"state": [{"anything-but": "stopped"}]}}`, true},
+		"anything-but mismatch":           {`{"detail": {"state": [{"anything-but": ["running", "stopped"]}]}}`, false},
+		"anything-but prefix":             {`{"detail": {"state": [{"anything-but": {"prefix": "run"}}]}}`, false},
+		"anything-but suffix":             {`{"detail": {"state": [{"anything-but": {"suffix": "ped"}}]}}`, true},
+		"anything-but equals-ignore-case": {`{"detail": {"state": [{"anything-but": {"equals-ignore-case": ["RUNNING"]}}]}}`, false},
+		"anything-but wildcard":           {`{"detail": {"state": [{"anything-but": {"wildcard": "stop*"}}]}}`, true},
+		"anything-but missing":            {`{"detail": {"missing": [{"anything-but": "x"}]}}`, false},
+		"numeric":                         {`{"detail": {"cpu": [{"numeric": [">", 80]}]}}`, true},
+		"numeric range":                   {`{"detail": {"cpu": [{"numeric": [">=", 0, "<", 80]}]}}`, false},
+		"numeric equals":                  {`{"detail": {"cpu": [{"numeric": ["=", 85.5]}]}}`, true},
+		"numeric string":                  {`{"detail": {"state": [{"numeric": [">", 0]}]}}`, false},
+		"exists":                          {`{"detail": {"state": [{"exists": true}]}}`, true},
+		"exists false":                    {`{"detail": {"missing": [{"exists": false}]}}`, true},
+		"exists false present":            {`{"detail": {"state": [{"exists": false}]}}`, false},
+		"cidr":                            {`{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`, true},
+		"cidr mismatch":                   {`{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`, false},
+		"equals-ignore-case":              {`{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`, true},
+		"wildcard":                        {`{"detail-type": [{"wildcard": "EC2 * State-change *"}]}`, true},
+		"wildcard mismatch":               {`{"detail-type": [{"wildcard": "EC2 * Failure"}]}`, false},
+		"array of objects":                {`{"detail": {"volumes": {"type": ["io2"], "size": [{"numeric": [">", 50]}]}}}`, true},
+		"array of objects mismatch":       {`{"detail": {"volumes": {"type": ["gp3"], "size": [{"numeric": [">", 50]}]}}}`, false},
+		"or":                              {`{"source": ["aws.ec2"], "$or": [{"detail": {"state": ["stopped"]}}, {"detail": {"cpu": [{"numeric": [">", 80]}]}}]}`, true},
+		"or mismatch":                     {`{"$or": [{"detail": {"state": ["stopped"]}}, {"account": ["111111111111"]}]}`, false},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := compileEventPattern(testCase.pattern)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			match, err := pattern.matchEvent(event)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if match != testCase.expected {
+				t.Errorf("expected match %t, got %t", testCase.expected, match)
+			}
+		})
+	}
+}
+
+func TestCompileEventPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern       string
+		expectedError string
+	}{
+		"not an object":           {`["aws.ec2"]`, "must be a JSON object"},
+		"empty":                   {`{}`, "must not be empty"},
+		"scalar value":            {`{"source": "aws.ec2"}`, "source: must be a JSON object or array"},
+		"empty array":             {`{"source": []}`, "source: must contain at least one value"},
+		"unsupported operator":    {`{"detail": {"state": [{"contains": "run"}]}}`, `detail.state[0].contains: unsupported operator "contains"`},
+		"two operators":           {`{"source": [{"prefix": "a", "suffix": "b"}]}`, "must contain exactly one operator"},
+		"invalid cidr":            {`{"ip": [{"cidr": "10.0.0.0/33"}]}`, "ip[0].cidr: invalid CIDR address"},
+		"invalid numeric":         {`{"n": [{"numeric": ["~", 1]}]}`, `unsupported comparison operator "~"`},
+		"invalid numeric range":   {`{"n": [{"numeric": ["<", 10, ">", 0]}]}`, "a range must be a lower bound"},
+		"numeric out of range":    {`{"n": [{"numeric": [">", 1e10]}]}`, "must be a number between"},
+		"exists not bool":         {`{"n": [{"exists": "yes"}]}`, "must be true or false"},
+		"wildcard consecutive":    {`{"s": [{"wildcard": "a**b"}]}`, "consecutive wildcard characters"},
+		"anything-but bad nested": {`{"s": [{"anything-but": {"cidr": "10.0.0.0/8"}}]}`, `unsupported operator "cidr"`},
+		"or single":               {`{"$or": [{"source": ["aws.ec2"]}]}`, "$or: must be an array of at least two event patterns"},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := compileEventPattern(testCase.pattern)
+
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
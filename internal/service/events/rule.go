@@ -344,6 +344,12 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJSONLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJSONLength, json))
 		}
+
+		// Check that the pattern only uses supported syntax and operators.
+		if _, err := compileEventPattern(json); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+		}
+
 		return
 	}
 }
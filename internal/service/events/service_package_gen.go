@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceConnection,
 			TypeName: "aws_cloudwatch_event_connection",
 		},
+		{
+			Factory:  DataSourcePatternMatch,
+			TypeName: "aws_cloudwatch_event_pattern_match",
+		},
 		{
 			Factory:  DataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
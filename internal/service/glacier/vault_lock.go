@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -143,7 +144,7 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("complete_lock", aws.StringValue(output.State) == "Locked")
 	d.Set("vault_name", d.Id())
 
-	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+	policyToSet, err := tfpolicy.ToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Glacier Vault Lock (%s): setting policy: %s", d.Id(), err)
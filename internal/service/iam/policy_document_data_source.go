@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -130,7 +131,7 @@ func DataSourcePolicyDocument() *schema.Resource {
 
 func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	mergedDoc := &IAMPolicyDoc{}
+	mergedDoc := &tfpolicy.Document{}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
@@ -153,7 +154,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				continue
 			}
 
-			sourceDoc := &IAMPolicyDoc{}
+			sourceDoc := &tfpolicy.Document{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
 			}
@@ -173,7 +174,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// process the current document
-	doc := &IAMPolicyDoc{
+	doc := &tfpolicy.Document{
 		Version: d.Get("version").(string),
 	}
 
@@ -183,12 +184,12 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
 		var cfgStmtIntf = cfgStmts.([]interface{})
-		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
+		stmts := make([]*tfpolicy.Statement, len(cfgStmtIntf))
 		sidMap := make(map[string]struct{})
 
 		for i, stmtI := range cfgStmtIntf {
 			cfgStmt := stmtI.(map[string]interface{})
-			stmt := &IAMPolicyStatement{
+			stmt := &tfpolicy.Statement{
 				Effect: cfgStmt["effect"].(string),
 			}
 
@@ -267,7 +268,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			if overrideJSON == nil {
 				continue
 			}
-			overrideDoc := &IAMPolicyDoc{}
+			overrideDoc := &tfpolicy.Document{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
 			}
@@ -278,7 +279,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	// merge in override_json
 	if v, ok := d.GetOk("override_json"); ok {
-		overrideDoc := &IAMPolicyDoc{}
+		overrideDoc := &tfpolicy.Document{}
 		if err := json.Unmarshal([]byte(v.(string)), overrideDoc); err != nil {
 			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override JSON: %s", err)
 		}
@@ -320,12 +321,12 @@ func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (
 	}
 }
 
-func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (IAMPolicyStatementConditionSet, error) {
-	out := make([]IAMPolicyStatementCondition, len(in))
+func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (tfpolicy.ConditionSet, error) {
+	out := make([]tfpolicy.Condition, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]interface{})
-		out[i] = IAMPolicyStatementCondition{
+		out[i] = tfpolicy.Condition{
 			Test:     item["test"].(string),
 			Variable: item["variable"].(string),
 		}
@@ -341,15 +342,15 @@ func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (I
 			out[i].Values = itemValues[0]
 		}
 	}
-	return IAMPolicyStatementConditionSet(out), nil
+	return tfpolicy.ConditionSet(out), nil
 }
 
-func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (IAMPolicyStatementPrincipalSet, error) {
-	out := make([]IAMPolicyStatementPrincipal, len(in))
+func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (tfpolicy.PrincipalSet, error) {
+	out := make([]tfpolicy.Principal, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]interface{})
-		out[i] = IAMPolicyStatementPrincipal{
+		out[i] = tfpolicy.Principal{
 			Type: item["type"].(string),
 		}
 		out[i].Identifiers, err = dataSourcePolicyDocumentReplaceVarsInList(
@@ -361,7 +362,7 @@ func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (I
 			return nil, fmt.Errorf("error reading identifiers: %w", err)
 		}
 	}
-	return IAMPolicyStatementPrincipalSet(out), nil
+	return tfpolicy.PrincipalSet(out), nil
 }
 
 func dataSourcePolicyPrincipalSchema() *schema.Schema {
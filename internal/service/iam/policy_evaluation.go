@@ -0,0 +1,603 @@
+package iam
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+// PolicyEvaluationDecision is the outcome of evaluating a request against a set of policies.
+type PolicyEvaluationDecision string
+
+const (
+	PolicyEvaluationDecisionAllow        PolicyEvaluationDecision = "Allow"
+	PolicyEvaluationDecisionExplicitDeny PolicyEvaluationDecision = "ExplicitDeny"
+	PolicyEvaluationDecisionImplicitDeny PolicyEvaluationDecision = "ImplicitDeny"
+)
+
+// PolicyEvaluationPrincipal identifies the caller of a simulated request,
+// e.g. {Type: "AWS", Identifier: "arn:aws:iam::123456789012:role/example"}.
+type PolicyEvaluationPrincipal struct {
+	Type       string
+	Identifier string
+}
+
+// PolicyEvaluationRequest describes a single simulated API request.
+// Context keys are matched case-insensitively.
+type PolicyEvaluationRequest struct {
+	Principal *PolicyEvaluationPrincipal
+	Action    string
+	Resource  string
+	Context   map[string][]string
+}
+
+// PolicyEvaluationMatch records a statement that applied to the request.
+type PolicyEvaluationMatch struct {
+	PolicyIndex    int
+	StatementIndex int
+	Sid            string
+	Effect         string
+}
+
+type PolicyEvaluationResult struct {
+	Decision          PolicyEvaluationDecision
+	MatchedStatements []PolicyEvaluationMatch
+}
+
+// EvaluatePolicies evaluates a request against a set of policy documents using
+// the standard IAM evaluation logic for a single account: an explicit Deny in
+// any statement overrides any Allow, and a request not allowed by any statement
+// is implicitly denied.
+func EvaluatePolicies(docs []*tfpolicy.Document, req *PolicyEvaluationRequest) (*PolicyEvaluationResult, error) {
+	reqContext := make(map[string][]string, len(req.Context))
+	for k, v := range req.Context {
+		reqContext[strings.ToLower(k)] = v
+	}
+	req = &PolicyEvaluationRequest{
+		Principal: req.Principal,
+		Action:    req.Action,
+		Resource:  req.Resource,
+		Context:   reqContext,
+	}
+
+	result := &PolicyEvaluationResult{
+		Decision: PolicyEvaluationDecisionImplicitDeny,
+	}
+
+	for i, doc := range docs {
+		if doc == nil {
+			continue
+		}
+
+		if err := tfpolicy.Validate(doc); err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		for j, stmt := range doc.Statements {
+			if stmt == nil {
+				continue
+			}
+
+			ok, err := policyStatementApplies(stmt, doc.Version, req)
+
+			if err != nil {
+				return nil, fmt.Errorf("policy %d, statement %d: %w", i, j, err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			result.MatchedStatements = append(result.MatchedStatements, PolicyEvaluationMatch{
+				PolicyIndex:    i,
+				StatementIndex: j,
+				Sid:            stmt.Sid,
+				Effect:         stmt.Effect,
+			})
+
+			switch stmt.Effect {
+			case tfpolicy.EffectDeny:
+				result.Decision = PolicyEvaluationDecisionExplicitDeny
+			case tfpolicy.EffectAllow:
+				if result.Decision == PolicyEvaluationDecisionImplicitDeny {
+					result.Decision = PolicyEvaluationDecisionAllow
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func policyStatementApplies(stmt *tfpolicy.Statement, version string, req *PolicyEvaluationRequest) (bool, error) {
+	vars := version == tfpolicy.Version2012_10_17
+
+	if v := stmt.Actions; v != nil {
+		if !policyActionMatchesAny(tfpolicy.StringSlice(v), req.Action) {
+			return false, nil
+		}
+	} else if v := stmt.NotActions; v != nil {
+		if policyActionMatchesAny(tfpolicy.StringSlice(v), req.Action) {
+			return false, nil
+		}
+	} else {
+		return false, nil
+	}
+
+	if v := stmt.Resources; v != nil {
+		if !policyResourceMatchesAny(tfpolicy.StringSlice(v), req.Resource, vars, req.Context) {
+			return false, nil
+		}
+	} else if v := stmt.NotResources; v != nil {
+		if policyResourceMatchesAny(tfpolicy.StringSlice(v), req.Resource, vars, req.Context) {
+			return false, nil
+		}
+	}
+
+	if len(stmt.Principals) > 0 {
+		if !policyPrincipalMatchesAny(stmt.Principals, req.Principal) {
+			return false, nil
+		}
+	} else if len(stmt.NotPrincipals) > 0 {
+		if policyPrincipalMatchesAny(stmt.NotPrincipals, req.Principal) {
+			return false, nil
+		}
+	}
+
+	for _, c := range stmt.Conditions {
+		ok, err := policyConditionMatches(c, vars, req.Context)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func policyActionMatchesAny(patterns []string, action string) bool {
+	for _, p := range patterns {
+		// Actions are case-insensitive.
+		if policyWildcardMatch(compilePolicyPattern(strings.ToLower(p)), strings.ToLower(action)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyResourceMatchesAny(patterns []string, resource string, vars bool, reqContext map[string][]string) bool {
+	for _, p := range patterns {
+		pattern, ok := expandPolicyVariables(p, vars, reqContext)
+
+		if !ok {
+			continue
+		}
+
+		if policyWildcardMatch(pattern, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyPrincipalMatchesAny(set tfpolicy.PrincipalSet, principal *PolicyEvaluationPrincipal) bool {
+	for _, p := range set {
+		for _, identifier := range tfpolicy.StringSlice(p.Identifiers) {
+			if p.Type == "*" && identifier == "*" {
+				return true
+			}
+
+			if principal == nil || p.Type != principal.Type {
+				continue
+			}
+
+			if identifier == "*" || identifier == principal.Identifier {
+				return true
+			}
+
+			if p.Type == "AWS" && policyAWSPrincipalCoversAccount(identifier, principal.Identifier) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+var (
+	policyAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+	policyActionRegexp    = regexp.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z]+$`)
+)
+
+// policyAWSPrincipalCoversAccount returns whether an account principal (either a bare account ID or
+// the account's root user ARN) covers the specified caller.
+func policyAWSPrincipalCoversAccount(identifier, caller string) bool {
+	var accountID string
+
+	if policyAccountIDRegexp.MatchString(identifier) {
+		accountID = identifier
+	} else if v, err := arn.Parse(identifier); err == nil && v.Service == "iam" && v.Resource == "root" {
+		accountID = v.AccountID
+	} else {
+		return false
+	}
+
+	if caller == accountID {
+		return true
+	}
+
+	if v, err := arn.Parse(caller); err == nil {
+		return v.AccountID == accountID
+	}
+
+	return false
+}
+
+type policyConditionOperator struct {
+	match   func(policyValue, contextValue string) (bool, error)
+	negated bool
+}
+
+func policyStringConditionOperator(fold bool) func(string, string) (bool, error) {
+	return func(policyValue, contextValue string) (bool, error) {
+		if fold {
+			return strings.EqualFold(policyValue, contextValue), nil
+		}
+		return policyValue == contextValue, nil
+	}
+}
+
+func policyStringLikeConditionOperator(policyValue, contextValue string) (bool, error) {
+	return policyWildcardMatch(compilePolicyPattern(policyValue), contextValue), nil
+}
+
+func policyNumericConditionOperator(cmp func(int) bool) func(string, string) (bool, error) {
+	return func(policyValue, contextValue string) (bool, error) {
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("parsing numeric condition value (%s): %w", policyValue, err)
+		}
+		c, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case c < p:
+			return cmp(-1), nil
+		case c > p:
+			return cmp(1), nil
+		default:
+			return cmp(0), nil
+		}
+	}
+}
+
+func parsePolicyDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02"} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date (%s)", s)
+}
+
+func policyDateConditionOperator(cmp func(int) bool) func(string, string) (bool, error) {
+	return func(policyValue, contextValue string) (bool, error) {
+		p, err := parsePolicyDate(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("parsing date condition value: %w", err)
+		}
+		c, err := parsePolicyDate(contextValue)
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case c.Before(p):
+			return cmp(-1), nil
+		case c.After(p):
+			return cmp(1), nil
+		default:
+			return cmp(0), nil
+		}
+	}
+}
+
+func policyBoolConditionOperator(policyValue, contextValue string) (bool, error) {
+	return strings.EqualFold(policyValue, contextValue), nil
+}
+
+func policyIPAddressConditionOperator(policyValue, contextValue string) (bool, error) {
+	ip := net.ParseIP(contextValue)
+	if ip == nil {
+		return false, nil
+	}
+
+	if !strings.Contains(policyValue, "/") {
+		v := net.ParseIP(policyValue)
+		if v == nil {
+			return false, fmt.Errorf("invalid IP address condition value (%s)", policyValue)
+		}
+		return v.Equal(ip), nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(policyValue)
+	if err != nil {
+		return false, fmt.Errorf("invalid IP address condition value (%s): %w", policyValue, err)
+	}
+
+	return ipNet.Contains(ip), nil
+}
+
+// policyARNConditionOperator implements ArnEquals and ArnLike, which behave identically:
+// each of the six colon-delimited ARN components is matched separately and may contain wildcards.
+func policyARNConditionOperator(policyValue, contextValue string) (bool, error) {
+	const arnSections = 6
+	p := strings.SplitN(policyValue, ":", arnSections)
+	c := strings.SplitN(contextValue, ":", arnSections)
+
+	if len(p) != arnSections || len(c) != arnSections {
+		return false, nil
+	}
+
+	for i := range p {
+		if !policyWildcardMatch(compilePolicyPattern(p[i]), c[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func policyCompareLess(n int) bool           { return n < 0 }
+func policyCompareLessOrEqual(n int) bool    { return n <= 0 }
+func policyCompareEqual(n int) bool          { return n == 0 }
+func policyCompareGreaterOrEqual(n int) bool { return n >= 0 }
+func policyCompareGreater(n int) bool        { return n > 0 }
+
+var policyConditionOperators = map[string]policyConditionOperator{
+	"StringEquals":              {match: policyStringConditionOperator(false)},
+	"StringNotEquals":           {match: policyStringConditionOperator(false), negated: true},
+	"StringEqualsIgnoreCase":    {match: policyStringConditionOperator(true)},
+	"StringNotEqualsIgnoreCase": {match: policyStringConditionOperator(true), negated: true},
+	"StringLike":                {match: policyStringLikeConditionOperator},
+	"StringNotLike":             {match: policyStringLikeConditionOperator, negated: true},
+	"NumericEquals":             {match: policyNumericConditionOperator(policyCompareEqual)},
+	"NumericNotEquals":          {match: policyNumericConditionOperator(policyCompareEqual), negated: true},
+	"NumericLessThan":           {match: policyNumericConditionOperator(policyCompareLess)},
+	"NumericLessThanEquals":     {match: policyNumericConditionOperator(policyCompareLessOrEqual)},
+	"NumericGreaterThan":        {match: policyNumericConditionOperator(policyCompareGreater)},
+	"NumericGreaterThanEquals":  {match: policyNumericConditionOperator(policyCompareGreaterOrEqual)},
+	"DateEquals":                {match: policyDateConditionOperator(policyCompareEqual)},
+	"DateNotEquals":             {match: policyDateConditionOperator(policyCompareEqual), negated: true},
+	"DateLessThan":              {match: policyDateConditionOperator(policyCompareLess)},
+	"DateLessThanEquals":        {match: policyDateConditionOperator(policyCompareLessOrEqual)},
+	"DateGreaterThan":           {match: policyDateConditionOperator(policyCompareGreater)},
+	"DateGreaterThanEquals":     {match: policyDateConditionOperator(policyCompareGreaterOrEqual)},
+	"Bool":                      {match: policyBoolConditionOperator},
+	"BinaryEquals":              {match: policyStringConditionOperator(false)},
+	"IpAddress":                 {match: policyIPAddressConditionOperator},
+	"NotIpAddress":              {match: policyIPAddressConditionOperator, negated: true},
+	"ArnEquals":                 {match: policyARNConditionOperator},
+	"ArnLike":                   {match: policyARNConditionOperator},
+	"ArnNotEquals":              {match: policyARNConditionOperator, negated: true},
+	"ArnNotLike":                {match: policyARNConditionOperator, negated: true},
+}
+
+const (
+	policyConditionOperatorNull = "Null"
+)
+
+// policyConditionMatches evaluates a single condition operator/key pair against the request context.
+func policyConditionMatches(c tfpolicy.Condition, vars bool, reqContext map[string][]string) (bool, error) {
+	test, forAll, forAny, ifExists := tfpolicy.ParseConditionOperator(c.Test)
+	contextValues, exists := reqContext[strings.ToLower(c.Variable)]
+	policyValues := tfpolicy.StringSlice(c.Values)
+
+	if c.Test == policyConditionOperatorNull {
+		for _, v := range policyValues {
+			want, err := strconv.ParseBool(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid Null condition value (%s)", v)
+			}
+			if want != !exists {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	op, ok := policyConditionOperators[test]
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator (%s)", c.Test)
+	}
+
+	if !exists || len(contextValues) == 0 {
+		switch {
+		case ifExists, forAll:
+			return true, nil
+		case forAny:
+			return false, nil
+		default:
+			return op.negated, nil
+		}
+	}
+
+	// A context value satisfies a positive operator if it matches any of the policy values,
+	// and satisfies a negated operator if it matches none of them.
+	matchOne := func(contextValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			if vars {
+				var ok bool
+				policyValue, ok = expandPolicyVariablesLiteral(policyValue, reqContext)
+				if !ok {
+					continue
+				}
+			}
+
+			ok, err := op.match(policyValue, contextValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return !op.negated, nil
+			}
+		}
+		return op.negated, nil
+	}
+
+	switch {
+	case forAll:
+		for _, v := range contextValues {
+			ok, err := matchOne(v)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case forAny:
+		for _, v := range contextValues {
+			ok, err := matchOne(v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case op.negated:
+		// Single-valued negated operators require every context value to not match.
+		for _, v := range contextValues {
+			ok, err := matchOne(v)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		for _, v := range contextValues {
+			ok, err := matchOne(v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+}
+
+// policyPatternRune is a single element of a compiled wildcard pattern.
+// Literal runes produced by policy variable substitution are never treated as wildcards.
+type policyPatternRune struct {
+	r       rune
+	literal bool
+}
+
+func compilePolicyPattern(s string) []policyPatternRune {
+	out := make([]policyPatternRune, 0, len(s))
+	for _, r := range s {
+		out = append(out, policyPatternRune{r: r})
+	}
+	return out
+}
+
+var policyVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// expandPolicyVariables replaces policy variables such as ${aws:username} with values from the
+// request context. The special variables ${*}, ${?} and ${$} expand to literal characters.
+// Returns false if a referenced variable is not present in the context.
+func expandPolicyVariables(s string, vars bool, reqContext map[string][]string) ([]policyPatternRune, bool) {
+	if !vars {
+		return compilePolicyPattern(s), true
+	}
+
+	var out []policyPatternRune
+	last := 0
+
+	for _, loc := range policyVariableRegexp.FindAllStringSubmatchIndex(s, -1) {
+		out = append(out, compilePolicyPattern(s[last:loc[0]])...)
+		last = loc[1]
+
+		name := s[loc[2]:loc[3]]
+		var value string
+
+		switch name {
+		case "*", "?", "$":
+			value = name
+		default:
+			values := reqContext[strings.ToLower(strings.TrimSpace(name))]
+			if len(values) != 1 {
+				return nil, false
+			}
+			value = values[0]
+		}
+
+		for _, r := range value {
+			out = append(out, policyPatternRune{r: r, literal: true})
+		}
+	}
+
+	out = append(out, compilePolicyPattern(s[last:])...)
+
+	return out, true
+}
+
+func expandPolicyVariablesLiteral(s string, reqContext map[string][]string) (string, bool) {
+	pattern, ok := expandPolicyVariables(s, true, reqContext)
+	if !ok {
+		return "", false
+	}
+
+	var sb strings.Builder
+	for _, p := range pattern {
+		sb.WriteRune(p.r)
+	}
+
+	return sb.String(), true
+}
+
+// policyWildcardMatch reports whether s matches pattern, where '*' matches any sequence of
+// characters (including none) and '?' matches any single character.
+func policyWildcardMatch(pattern []policyPatternRune, s string) bool {
+	str := []rune(s)
+	p, i := 0, 0
+	starP, starI := -1, 0
+
+	for i < len(str) {
+		switch {
+		case p < len(pattern) && !pattern[p].literal && pattern[p].r == '*':
+			starP, starI = p, i
+			p++
+		case p < len(pattern) && ((!pattern[p].literal && pattern[p].r == '?') || pattern[p].r == str[i]):
+			p++
+			i++
+		case starP >= 0:
+			starI++
+			p, i = starP+1, starI
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && !pattern[p].literal && pattern[p].r == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
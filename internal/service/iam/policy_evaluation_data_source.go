@@ -0,0 +1,177 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation")
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(policyActionRegexp, "must be of the form service:Action"),
+			},
+			"allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"matched_statements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_documents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"principal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var docs []*tfpolicy.Document
+	for i, v := range d.Get("policy_documents").([]interface{}) {
+		doc := &tfpolicy.Document{}
+
+		if v != nil {
+			if err := json.Unmarshal([]byte(v.(string)), doc); err != nil {
+				return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: parsing policy document %d: %s", i, err)
+			}
+		}
+
+		docs = append(docs, doc)
+	}
+
+	req := &PolicyEvaluationRequest{
+		Action:   d.Get("action").(string),
+		Resource: d.Get("resource_arn").(string),
+		Context:  make(map[string][]string),
+	}
+
+	if v, ok := d.GetOk("principal"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		req.Principal = &PolicyEvaluationPrincipal{
+			Type:       tfMap["type"].(string),
+			Identifier: tfMap["identifier"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			key := tfMap["key"].(string)
+			req.Context[key] = append(req.Context[key], flex.ExpandStringValueList(tfMap["values"].([]interface{}))...)
+		}
+	}
+
+	result, err := EvaluatePolicies(docs, req)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+
+	var tfList []interface{}
+	for _, v := range result.MatchedStatements {
+		tfList = append(tfList, map[string]interface{}{
+			"effect":          v.Effect,
+			"policy_index":    v.PolicyIndex,
+			"sid":             v.Sid,
+			"statement_index": v.StatementIndex,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join([]string{req.Action, req.Resource, string(result.Decision)}, ","))))
+	d.Set("allowed", result.Decision == PolicyEvaluationDecisionAllow)
+	d.Set("decision", string(result.Decision))
+	if err := d.Set("matched_statements", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting matched_statements: %s", err)
+	}
+
+	return diags
+}
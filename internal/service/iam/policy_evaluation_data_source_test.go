@@ -0,0 +1,102 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "Allow"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.0.sid", "AllowRead"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_explicitDeny(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_explicitDeny,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "ExplicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "AllowRead"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  policy_documents = [data.aws_iam_policy_document.test.json]
+  action           = "s3:GetObject"
+  resource_arn     = "arn:aws:s3:::example/key"
+}
+`
+
+var testAccPolicyEvaluationDataSourceConfig_explicitDeny = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "AllowAll"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "DenyInsecureTransport"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["*"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  policy_documents = [data.aws_iam_policy_document.test.json]
+  action           = "s3:PutObject"
+  resource_arn     = "arn:aws:s3:::example/key"
+
+  context {
+    key    = "aws:SecureTransport"
+    values = ["false"]
+  }
+}
+`
@@ -0,0 +1,319 @@
+package iam
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policies []string
+		request  PolicyEvaluationRequest
+		expected PolicyEvaluationDecision
+		err      string
+	}{
+		"exact action and resource": {
+			policies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/key"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"action is case insensitive": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "S3:getobject", "Resource": "*"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"action wildcards": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": ["ec2:Describe*", "s3:?etObject"], "Resource": "*"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"resource is case sensitive": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::Example/*"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"no matching statement": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"explicit deny overrides allow": {
+			policies: []string{
+				`{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+				`{"Statement": [{"Effect": "Deny", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"}]}`,
+			},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionExplicitDeny,
+		},
+		"not action": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Deny", "NotAction": "iam:*", "Resource": "*"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "iam:CreateRole", Resource: "*"},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"not resource": {
+			policies: []string{`{
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:*", "Resource": "*"},
+    {"Effect": "Deny", "Action": "s3:*", "NotResource": "arn:aws:s3:::allowed/*"}
+  ]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::other/key"},
+			expected: PolicyEvaluationDecisionExplicitDeny,
+		},
+		"principal account ID": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Principal": {"AWS": "123456789012"}, "Action": "sts:AssumeRole"}]
+}`},
+			request: PolicyEvaluationRequest{
+				Principal: &PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::123456789012:role/example"},
+				Action:    "sts:AssumeRole",
+				Resource:  "*",
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"principal other account": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "sts:AssumeRole"}]
+}`},
+			request: PolicyEvaluationRequest{
+				Principal: &PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::210987654321:role/example"},
+				Action:    "sts:AssumeRole",
+				Resource:  "*",
+			},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"principal wildcard": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"not principal": {
+			policies: []string{`{
+  "Statement": [
+    {"Effect": "Allow", "Principal": "*", "Action": "s3:*", "Resource": "*"},
+    {"Effect": "Deny", "NotPrincipal": {"Service": "cloudtrail.amazonaws.com"}, "Action": "s3:PutObject", "Resource": "*"}
+  ]
+}`},
+			request: PolicyEvaluationRequest{
+				Principal: &PolicyEvaluationPrincipal{Type: "Service", Identifier: "cloudtrail.amazonaws.com"},
+				Action:    "s3:PutObject",
+				Resource:  "arn:aws:s3:::example/key",
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"condition string equals": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "ec2:*", "Resource": "*", "Condition": {"StringEquals": {"aws:RequestedRegion": ["us-west-2", "us-east-1"]}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "ec2:RunInstances",
+				Resource: "*",
+				Context:  map[string][]string{"aws:requestedregion": {"us-east-1"}},
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"condition key missing": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "ec2:*", "Resource": "*", "Condition": {"StringEquals": {"aws:RequestedRegion": "us-west-2"}}}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"condition negated key missing": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Deny", "Action": "ec2:*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:RequestedRegion": "us-west-2"}}}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			expected: PolicyEvaluationDecisionExplicitDeny,
+		},
+		"condition if exists": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "ec2:*", "Resource": "*", "Condition": {"StringEqualsIfExists": {"ec2:InstanceType": "t3.micro"}}}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "ec2:DescribeInstances", Resource: "*"},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"condition string like": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"StringLike": {"s3:prefix": "home/*"}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "s3:ListBucket",
+				Resource: "arn:aws:s3:::example",
+				Context:  map[string][]string{"s3:prefix": {"home/user/"}},
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"condition numeric": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"NumericLessThanEquals": {"s3:max-keys": 10}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "s3:ListBucket",
+				Resource: "*",
+				Context:  map[string][]string{"s3:max-keys": {"20"}},
+			},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"condition date": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"DateLessThan": {"aws:CurrentTime": "2030-01-01T00:00:00Z"}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "s3:ListBucket",
+				Resource: "*",
+				Context:  map[string][]string{"aws:CurrentTime": {"2029-06-30T12:00:00Z"}},
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"condition bool": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": false}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "*",
+				Context:  map[string][]string{"aws:SecureTransport": {"false"}},
+			},
+			expected: PolicyEvaluationDecisionExplicitDeny,
+		},
+		"condition ip address": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"NotIpAddress": {"aws:SourceIp": ["192.0.2.0/24", "203.0.113.0/24"]}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "*",
+				Context:  map[string][]string{"aws:SourceIp": {"203.0.113.10"}},
+			},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"condition arn like": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*", "Condition": {"ArnLike": {"aws:SourceArn": "arn:aws:sns:*:123456789012:*"}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "sqs:SendMessage",
+				Resource: "*",
+				Context:  map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:example"}},
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"condition null": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Deny", "Action": "ec2:RunInstances", "Resource": "*", "Condition": {"Null": {"aws:RequestTag/Owner": "true"}}}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			expected: PolicyEvaluationDecisionExplicitDeny,
+		},
+		"condition for all values": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"ForAllValues:StringEquals": {"aws:TagKeys": ["Owner", "CostCenter"]}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "ec2:CreateTags",
+				Resource: "*",
+				Context:  map[string][]string{"aws:TagKeys": {"Owner", "Name"}},
+			},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"condition for any value": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"ForAnyValue:StringEquals": {"aws:TagKeys": ["Owner", "CostCenter"]}}}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "ec2:CreateTags",
+				Resource: "*",
+				Context:  map[string][]string{"aws:TagKeys": {"Owner", "Name"}},
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"policy variable in resource": {
+			policies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example/home/${aws:username}/*"}]
+}`},
+			request: PolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::example/home/jdoe/file",
+				Context:  map[string][]string{"aws:username": {"jdoe"}},
+			},
+			expected: PolicyEvaluationDecisionAllow,
+		},
+		"policy variable literal wildcard": {
+			policies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example/${*}"}]
+}`},
+			request:  PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/file"},
+			expected: PolicyEvaluationDecisionImplicitDeny,
+		},
+		"unsupported condition operator": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"StringSortOf": {"aws:username": "x"}}}]
+}`},
+			request: PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			err:     "unsupported condition operator",
+		},
+		"invalid effect": {
+			policies: []string{`{
+  "Statement": [{"Effect": "Maybe", "Action": "*", "Resource": "*"}]
+}`},
+			request: PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			err:     "invalid Effect",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var docs []*tfpolicy.Document
+			for _, v := range testCase.policies {
+				doc := &tfpolicy.Document{}
+				if err := json.Unmarshal([]byte(v), doc); err != nil {
+					t.Fatalf("parsing policy: %s", err)
+				}
+				docs = append(docs, doc)
+			}
+
+			result, err := EvaluatePolicies(docs, &testCase.request)
+
+			if testCase.err != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.err) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := result.Decision, testCase.expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
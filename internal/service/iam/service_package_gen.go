@@ -53,6 +53,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePolicyDocument,
 			TypeName: "aws_iam_policy_document",
 		},
+		{
+			Factory:  DataSourcePolicyEvaluation,
+			TypeName: "aws_iam_policy_evaluation",
+		},
 		{
 			Factory:  DataSourceRole,
 			TypeName: "aws_iam_role",
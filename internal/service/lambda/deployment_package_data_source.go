@@ -0,0 +1,145 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// @SDKDataSource("aws_lambda_deployment_package")
+func DataSourceDeploymentPackage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeploymentPackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"excludes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"files": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"output_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_code_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building Lambda deployment package: %s", err)
+	}
+
+	outputPath, err := homedir.Expand(d.Get("output_path").(string))
+
+	if err == nil {
+		outputPath, err = filepath.Abs(outputPath)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building Lambda deployment package: %s", err)
+	}
+
+	includes := flex.ExpandStringValueList(d.Get("includes").([]interface{}))
+	excludes := flex.ExpandStringValueList(d.Get("excludes").([]interface{}))
+
+	pkg, err := buildDeploymentPackage(sourceDir, includes, excludes, outputPath)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building Lambda deployment package (%s): %s", sourceDir, err)
+	}
+
+	if len(pkg.files) == 0 {
+		return sdkdiag.AppendErrorf(diags, "building Lambda deployment package (%s): no files match includes and excludes", sourceDir)
+	}
+
+	// Only write the package when its content changes, so that the file is left untouched
+	// when nothing in the source directory has changed.
+	if existing, err := os.ReadFile(outputPath); err != nil || !bytes.Equal(existing, pkg.content) {
+		if err := writeDeploymentPackage(outputPath, pkg.content); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing Lambda deployment package (%s): %s", outputPath, err)
+		}
+	}
+
+	hash := sourceCodeHash(pkg.content)
+
+	d.SetId(hash)
+	d.Set("files", pkg.files)
+	d.Set("output_size", len(pkg.content))
+	d.Set("source_code_hash", hash)
+
+	return diags
+}
+
+// writeDeploymentPackage writes a package atomically, so that a concurrent reader never
+// sees a partially written file.
+func writeDeploymentPackage(outputPath string, content []byte) error {
+	dir := filepath.Dir(outputPath)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(outputPath)+".*.tmp")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), outputPath)
+}
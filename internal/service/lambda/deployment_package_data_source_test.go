@@ -0,0 +1,114 @@
+package lambda_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaDeploymentPackageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_deployment_package.test"
+	outputPath := filepath.Join(t.TempDir(), "package.zip")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentPackageDataSourceConfig_basic(outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "files.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.0", "index.js"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.1", "lib/util.js"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_path", outputPath),
+					resource.TestCheckResourceAttrSet(dataSourceName, "output_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_code_hash", "data.aws_lambda_deployment_package.same", "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaDeploymentPackageDataSource_function(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_deployment_package.test"
+	resourceName := "aws_lambda_function.test"
+	outputPath := filepath.Join(t.TempDir(), "package.zip")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentPackageDataSourceConfig_function(rName, outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", dataSourceName, "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentPackageDataSourceConfig_basic(outputPath string) string {
+	return fmt.Sprintf(`
+data "aws_lambda_deployment_package" "test" {
+  source_dir  = "test-fixtures/deployment_package"
+  output_path = %[1]q
+  includes    = ["**/*.js"]
+  excludes    = ["tests"]
+}
+
+data "aws_lambda_deployment_package" "same" {
+  source_dir  = "test-fixtures/deployment_package"
+  output_path = %[2]q
+  includes    = ["index.js", "lib"]
+}
+`, outputPath, outputPath+".same")
+}
+
+func testAccDeploymentPackageDataSourceConfig_function(rName, outputPath string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_deploymentPackageBase(rName), fmt.Sprintf(`
+data "aws_lambda_deployment_package" "test" {
+  source_dir  = "test-fixtures/deployment_package"
+  output_path = %[2]q
+  excludes    = ["tests", "*.md"]
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = data.aws_lambda_deployment_package.test.output_path
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "index.handler"
+  runtime       = "nodejs16.x"
+}
+`, rName, outputPath))
+}
+
+func testAccFunctionConfig_deploymentPackageBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "lambda.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+`, rName)
+}
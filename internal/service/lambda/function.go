@@ -16,6 +16,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -165,10 +167,14 @@ func ResourceFunction() *schema.Resource {
 					},
 				},
 			},
+			// filename may be set together with s3_bucket and s3_key, in which case the deployment package is
+			// uploaded to S3 and the function is deployed from there, so at least one (rather than exactly one)
+			// of filename, image_uri and s3_bucket is required. The uploaded object's version is always deployed.
 			"filename": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", "s3_bucket"},
+				ConflictsWith: []string{"image_uri", "s3_object_version"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -205,9 +211,10 @@ func ResourceFunction() *schema.Resource {
 				},
 			},
 			"image_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", "s3_bucket"},
+				ConflictsWith: []string{"filename", "s3_bucket"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -284,10 +291,11 @@ func ResourceFunction() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.Runtime](),
 			},
 			"s3_bucket": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
-				RequiredWith: []string{"s3_key"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", "s3_bucket"},
+				ConflictsWith: []string{"image_uri"},
+				RequiredWith:  []string{"s3_key"},
 			},
 			"s3_key": {
 				Type:         schema.TypeString,
@@ -411,6 +419,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			computeSourceCodeHash,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -439,7 +448,18 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Timeout:      aws.Int32(int32(d.Get("timeout").(int))),
 	}
 
-	if v, ok := d.GetOk("filename"); ok {
+	if v, ok := d.GetOk("filename"); ok && d.Get("s3_bucket").(string) != "" {
+		bucket, key := d.Get("s3_bucket").(string), d.Get("s3_key").(string)
+		versionID, err := uploadFunctionPackage(ctx, meta.(*conns.AWSClient).S3Conn(), v.(string), bucket, key)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading Lambda Function (%s) deployment package: %s", functionName, err)
+		}
+
+		input.Code.S3Bucket = aws.String(bucket)
+		input.Code.S3Key = aws.String(key)
+		input.Code.S3ObjectVersion = versionID
+	} else if v, ok := d.GetOk("filename"); ok {
 		// Grab an exclusive lock so that we're only reading one function into memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364.
 		conns.GlobalMutexKV.Lock(mutexKey)
@@ -884,7 +904,24 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		if v, ok := d.GetOk("filename"); ok {
+		if v, ok := d.GetOk("filename"); ok && d.Get("s3_bucket").(string) != "" {
+			bucket, key := d.Get("s3_bucket").(string), d.Get("s3_key").(string)
+			versionID, err := uploadFunctionPackage(ctx, meta.(*conns.AWSClient).S3Conn(), v.(string), bucket, key)
+
+			if err != nil {
+				// As filename, s3_bucket and s3_key aren't set in resourceFunctionRead(), don't ovewrite the last known good values.
+				for _, key := range []string{"filename", "s3_bucket", "s3_key"} {
+					old, _ := d.GetChange(key)
+					d.Set(key, old)
+				}
+
+				return sdkdiag.AppendErrorf(diags, "uploading Lambda Function (%s) deployment package: %s", d.Id(), err)
+			}
+
+			input.S3Bucket = aws.String(bucket)
+			input.S3Key = aws.String(key)
+			input.S3ObjectVersion = versionID
+		} else if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
 			conns.GlobalMutexKV.Lock(mutexKey)
@@ -1261,6 +1298,39 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// computeSourceCodeHash sets source_code_hash to the hash of the deployment package in filename
+// when source_code_hash is not configured, so that changes to the package update the function's code.
+func computeSourceCodeHash(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("source_code_hash").IsNull() {
+		return nil
+	}
+
+	// Lambda returns the hash of the signed package for functions with code signing.
+	if _, ok := d.GetOk("code_signing_config_arn"); ok {
+		return nil
+	}
+
+	v, ok := d.GetOk("filename")
+
+	if !ok {
+		return nil
+	}
+
+	hash, err := sourceCodeHashFile(v.(string))
+
+	if err != nil {
+		// The package may not exist until it is built during apply.
+		log.Printf("[DEBUG] Not computing Lambda Function source_code_hash: %s", err)
+		return nil
+	}
+
+	if hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1308,6 +1378,36 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("ephemeral_storage")
 }
 
+// uploadFunctionPackage uploads a deployment package to S3 using the S3 transfer manager,
+// returning the version ID of the object in versioned buckets.
+func uploadFunctionPackage(ctx context.Context, conn *s3.S3, v, bucket, key string) (*string, error) {
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	output, err := s3manager.NewUploaderWithClient(conn).UploadWithContext(ctx, &s3manager.UploadInput{
+		Body:   f,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading %s to S3 Bucket (%s) Object (%s): %w", v, bucket, key, err)
+	}
+
+	return output.VersionID, nil
+}
+
 func readFileContents(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
 	if err != nil {
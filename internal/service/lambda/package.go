@@ -0,0 +1,274 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// packageModTime is the modification time of every file in a deployment package,
+// so that packages built from the same files are identical. It is the earliest
+// time that can be represented in a ZIP file.
+var packageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// deploymentPackage is a ZIP file deployment package built from a source directory.
+type deploymentPackage struct {
+	content []byte
+	files   []string
+}
+
+// sourceCodeHash returns the base64-encoded SHA256 hash of a deployment package,
+// as returned by Lambda in CodeSha256.
+func sourceCodeHash(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// sourceCodeHashFile returns the source code hash of a deployment package file without
+// reading the whole file into memory.
+func sourceCodeHashFile(v string) (string, error) {
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// buildDeploymentPackage builds a reproducible ZIP file deployment package from the files in
+// sourceDir that match any of the include patterns and none of the exclude patterns.
+// Files are added in lexical order, with a fixed modification time and with permissions
+// reduced to 0644, or 0755 for executable files. Patterns are matched against paths relative
+// to sourceDir using forward slashes, and also match every file below a matching directory.
+// skip is an absolute path, such as the package's output path, that is never added.
+func buildDeploymentPackage(sourceDir string, includes, excludes []string, skip string) (*deploymentPackage, error) {
+	for _, pattern := range append(append([]string{}, includes...), excludes...) {
+		if err := validPackagePattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(includes) == 0 {
+		includes = []string{"**"}
+	}
+
+	root, err := filepath.Abs(sourceDir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Stat(root); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", sourceDir)
+	}
+
+	var files []string
+
+	err = filepath.WalkDir(root, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if filename == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, filename)
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if matchAnyPackagePattern(excludes, name) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() || filename == skip {
+			return nil
+		}
+
+		if entry.Type()&fs.ModeSymlink != 0 {
+			info, err := os.Stat(filename)
+
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() {
+				return fmt.Errorf("%s: symbolic links to directories are not supported", name)
+			}
+		} else if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		if matchAnyPackagePattern(includes, name) {
+			files = append(files, name)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range files {
+		if err := addPackageFile(w, filepath.Join(root, filepath.FromSlash(name)), name); err != nil {
+			return nil, fmt.Errorf("adding %s: %w", name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &deploymentPackage{
+		content: buf.Bytes(),
+		files:   files,
+	}, nil
+}
+
+func addPackageFile(w *zip.Writer, filename, name string) error {
+	info, err := os.Stat(filename)
+
+	if err != nil {
+		return err
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: packageModTime,
+	}
+
+	if info.Mode().Perm()&0111 != 0 {
+		header.SetMode(0755)
+	} else {
+		header.SetMode(0644)
+	}
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	fw, err := w.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, f)
+
+	return err
+}
+
+func validPackagePattern(pattern string) error {
+	if pattern == "" || strings.HasPrefix(pattern, "/") {
+		return fmt.Errorf("invalid pattern %q: must be a non-empty path relative to the source directory", pattern)
+	}
+
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func matchAnyPackagePattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchPackagePattern(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPackagePattern returns whether a slash-separated path, or one of its parent
+// directories, matches a pattern. Pattern segments are matched using path.Match, and a
+// "**" segment matches any number of path segments.
+func matchPackagePattern(pattern, name string) bool {
+	patternSegments := strings.Split(strings.TrimSuffix(pattern, "/"), "/")
+	nameSegments := strings.Split(name, "/")
+
+	for i := 1; i <= len(nameSegments); i++ {
+		if matchPackagePatternSegments(patternSegments, nameSegments[:i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPackagePatternSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchPackagePatternSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,165 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMatchPackagePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"**", "index.js", true},
+		{"**", "lib/util.js", true},
+		{"*.js", "index.js", true},
+		{"*.js", "lib/util.js", false},
+		{"**/*.js", "index.js", true},
+		{"**/*.js", "lib/nested/util.js", true},
+		{"lib", "lib/util.js", true},
+		{"lib/", "lib/util.js", true},
+		{"lib", "library/util.js", false},
+		{"**/node_modules", "node_modules/a/index.js", true},
+		{"**/node_modules", "lib/node_modules/a/index.js", true},
+		{"**/__pycache__/**", "pkg/__pycache__/mod.pyc", true},
+		{"lib/**/test_*.py", "lib/test_a.py", true},
+		{"lib/**/test_*.py", "lib/a/b/test_a.py", true},
+		{"lib/**/test_*.py", "test_a.py", false},
+		{"?.txt", "a.txt", true},
+		{"[ab].txt", "c.txt", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := matchPackagePattern(testCase.pattern, testCase.name); got != testCase.expected {
+			t.Errorf("matchPackagePattern(%q, %q) = %t, expected %t", testCase.pattern, testCase.name, got, testCase.expected)
+		}
+	}
+}
+
+func TestBuildDeploymentPackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.js":                 "exports.handler = async () => {};",
+		"bootstrap":                "#!/bin/sh",
+		"lib/util.js":              "exports.util = () => {};",
+		"node_modules/a/index.js":  "module.exports = {};",
+		"tests/util.test.js":       "test();",
+		"README.md":                "# Test",
+		"lib/nested/data/file.txt": "data",
+	} {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "bootstrap"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "package.zip")
+	includes := []string{"**"}
+	excludes := []string{"tests", "*.md"}
+
+	pkg, err := buildDeploymentPackage(dir, includes, excludes, outputPath)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedFiles := []string{"bootstrap", "index.js", "lib/nested/data/file.txt", "lib/util.js", "node_modules/a/index.js"}
+
+	if !reflect.DeepEqual(pkg.files, expectedFiles) {
+		t.Errorf("expected files %v, got %v", expectedFiles, pkg.files)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg.content), int64(len(pkg.content)))
+
+	if err != nil {
+		t.Fatalf("reading package: %s", err)
+	}
+
+	for _, f := range r.File {
+		expectedMode := os.FileMode(0644)
+
+		if f.Name == "bootstrap" {
+			expectedMode = 0755
+		}
+
+		if got := f.Mode().Perm(); got != expectedMode {
+			t.Errorf("%s: expected mode %s, got %s", f.Name, expectedMode, got)
+		}
+
+		if !f.Modified.Equal(packageModTime) {
+			t.Errorf("%s: expected modification time %s, got %s", f.Name, packageModTime, f.Modified)
+		}
+	}
+
+	// The package is unchanged by modification times, permissions other than the executable bit,
+	// and by the package itself being written to the source directory.
+	if err := os.WriteFile(outputPath, pkg.content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), time.Now(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "lib/util.js"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	rebuilt, err := buildDeploymentPackage(dir, includes, excludes, outputPath)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := sourceCodeHash(rebuilt.content), sourceCodeHash(pkg.content); got != expected {
+		t.Errorf("expected rebuilt package hash %s, got %s", expected, got)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "lib/util.js"), []byte("exports.util = () => 1;"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	modified, err := buildDeploymentPackage(dir, includes, excludes, outputPath)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if sourceCodeHash(modified.content) == sourceCodeHash(pkg.content) {
+		t.Error("expected modified package hash to differ")
+	}
+
+	if hash, err := sourceCodeHashFile(outputPath); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if expected := sourceCodeHash(pkg.content); hash != expected {
+		t.Errorf("expected file hash %s, got %s", expected, hash)
+	}
+
+	if _, err := buildDeploymentPackage(dir, []string{"/index.js"}, nil, outputPath); err == nil {
+		t.Error("expected error for absolute pattern")
+	}
+
+	if _, err := buildDeploymentPackage(dir, []string{"[a"}, nil, outputPath); err == nil {
+		t.Error("expected error for malformed pattern")
+	}
+}
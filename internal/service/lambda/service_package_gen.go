@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCodeSigningConfig,
 			TypeName: "aws_lambda_code_signing_config",
 		},
+		{
+			Factory:  DataSourceDeploymentPackage,
+			TypeName: "aws_lambda_deployment_package",
+		},
 		{
 			Factory:  DataSourceFunction,
 			TypeName: "aws_lambda_function",
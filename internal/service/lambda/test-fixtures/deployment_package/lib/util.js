@@ -0,0 +1 @@
+exports.message = () => "hello";
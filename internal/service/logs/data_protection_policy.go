@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -97,7 +98,7 @@ func resourceDataProtectionPolicyRead(ctx context.Context, d *schema.ResourceDat
 
 	d.Set("log_group_name", output.LogGroupIdentifier)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), aws.ToString(output.PolicyDocument))
+	policyToSet, err := tfpolicy.SecondUnlessEquivalent(d.Get("policy_document").(string), aws.ToString(output.PolicyDocument))
 
 	if err != nil {
 		return diag.Errorf("while setting policy (%s), encountered: %s", policyToSet, err)
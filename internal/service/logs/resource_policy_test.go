@@ -3,6 +3,7 @@ package logs_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/mockaws"
 	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -84,6 +86,80 @@ func TestAccLogsResourcePolicy_ignoreEquivalent(t *testing.T) {
 	})
 }
 
+func TestResourcePolicyCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := mockaws.NewServer(t)
+	r := &mockaws.SDKResource{
+		Resource: tflogs.ResourceResourcePolicy(),
+		Meta:     server.Client(ctx),
+	}
+	policyDocument := `{"Statement":[{"Action":"logs:PutLogEvents","Effect":"Allow","Principal":{"Service":"rds.amazonaws.com"},"Resource":"*"}],"Version":"2012-10-17"}`
+	resourcePolicy := map[string]any{
+		"policyDocument": policyDocument,
+		"policyName":     "test",
+	}
+
+	server.Handle("PutResourcePolicy", mockaws.JSON(map[string]any{
+		"resourcePolicy": resourcePolicy,
+	}))
+	server.Handle("DescribeResourcePolicies",
+		// Create.
+		mockaws.JSON(map[string]any{
+			"resourcePolicies": []any{resourcePolicy},
+		}),
+		// Read.
+		mockaws.JSON(map[string]any{
+			"resourcePolicies": []any{resourcePolicy},
+		}),
+		// Read after deletion.
+		mockaws.JSON(map[string]any{
+			"resourcePolicies": []any{},
+		}),
+	)
+	server.Handle("DeleteResourcePolicy", mockaws.JSONError(http.StatusBadRequest, cloudwatchlogs.ErrCodeResourceNotFoundException, "Policy with name [test] does not exist"))
+
+	state, diags := r.Create(ctx, map[string]any{
+		"policy_document": policyDocument,
+		"policy_name":     "test",
+	})
+
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+
+	if got, want := state.ID, "test"; got != want {
+		t.Errorf("ID = %s, want %s", got, want)
+	}
+
+	state, diags = r.Read(ctx, state)
+
+	if diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+
+	if got, want := state.Attributes["policy_document"], policyDocument; got != want {
+		t.Errorf("policy_document = %s, want %s", got, want)
+	}
+
+	// Deleting a resource that no longer exists succeeds.
+	if diags := r.Delete(ctx, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	// The resource is removed from state when not found.
+	state, diags = r.Read(ctx, state)
+
+	if diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+
+	if state != nil {
+		t.Errorf("expected resource to be removed from state, got: %v", state)
+	}
+}
+
 func testAccCheckResourcePolicyExists(ctx context.Context, n string, v *cloudwatchlogs.ResourcePolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
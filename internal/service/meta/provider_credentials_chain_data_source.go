@@ -0,0 +1,103 @@
+package meta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource
+func newDataSourceProviderCredentialsChain(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceProviderCredentialsChain{}
+
+	return d, nil
+}
+
+type dataSourceProviderCredentialsChain struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceProviderCredentialsChain) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_provider_credentials_chain"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceProviderCredentialsChain) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			"provider_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"source": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceProviderCredentialsChain) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceProviderCredentialsChainData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	chain, err := d.Meta().CredentialsChain(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading provider credentials chain", err.Error())
+
+		return
+	}
+
+	data.AccountID = types.StringValue(chain.AccountID)
+	data.AssumedRoleARNs = flex.FlattenFrameworkStringValueList(ctx, chain.AssumedRoles)
+	if chain.CanExpire {
+		data.Expiration = types.StringValue(chain.Expires.Format(time.RFC3339))
+	} else {
+		data.Expiration = types.StringNull()
+	}
+	data.ID = types.StringValue(chain.AccountID)
+	data.Partition = types.StringValue(chain.Partition)
+	data.ProviderName = types.StringValue(chain.ProviderName)
+	data.Source = types.StringValue(chain.Source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceProviderCredentialsChainData struct {
+	AccountID       types.String `tfsdk:"account_id"`
+	AssumedRoleARNs types.List   `tfsdk:"assumed_role_arns"`
+	Expiration      types.String `tfsdk:"expiration"`
+	ID              types.String `tfsdk:"id"`
+	Partition       types.String `tfsdk:"partition"`
+	ProviderName    types.String `tfsdk:"provider_name"`
+	Source          types.String `tfsdk:"source"`
+}
@@ -0,0 +1,37 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaProviderCredentialsChainDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_credentials_chain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCredentialsChainDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "partition", "data.aws_partition.current", "partition"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provider_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderCredentialsChainDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_provider_credentials_chain" "test" {}
+`
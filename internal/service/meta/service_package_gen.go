@@ -27,6 +27,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourcePartition,
 		},
+		{
+			Factory: newDataSourceProviderCredentialsChain,
+		},
 		{
 			Factory: newDataSourceRegion,
 		},
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -118,7 +119,7 @@ func resourceSinkPolicyRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("sink_id", out.SinkId)
 	d.Set("sink_identifier", d.Id())
 
-	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.ToString(out.Policy))
+	policyToSet, err := tfpolicy.ToSet(d.Get("policy").(string), aws.ToString(out.Policy))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -105,18 +105,18 @@ func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, met
 
 	doc.Statement.Resources = nil
 
-	policyDoc := tfiam.IAMPolicyDoc{}
+	policyDoc := tfpolicy.Document{}
 
 	policyDoc.Id = doc.Id
 	policyDoc.Version = doc.Version
-	policyDoc.Statements = []*tfiam.IAMPolicyStatement{doc.Statement}
+	policyDoc.Statements = []*tfpolicy.Statement{doc.Statement}
 
 	formattedPolicy, err := json.Marshal(policyDoc)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "marshling policy: %s", err)
 	}
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), string(formattedPolicy))
+	policyToSet, err := tfpolicy.SecondUnlessEquivalent(d.Get("policy").(string), string(formattedPolicy))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
@@ -154,7 +154,7 @@ func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, m
 }
 
 type resourcePolicyDoc struct {
-	Version   string                    `json:",omitempty"`
-	Id        string                    `json:",omitempty"`
-	Statement *tfiam.IAMPolicyStatement `json:"Statement,omitempty"`
+	Version   string              `json:",omitempty"`
+	Id        string              `json:",omitempty"`
+	Statement *tfpolicy.Statement `json:"Statement,omitempty"`
 }
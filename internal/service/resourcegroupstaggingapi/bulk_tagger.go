@@ -0,0 +1,77 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// The Resource Groups Tagging API adds or removes at most 50 tags at once.
+const bulkTagsChunkSize = 50
+
+type bulkTagger struct {
+	conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
+}
+
+// NewBulkTagger returns a tftags.BulkTagger that tags resources, identified by ARN, using the Resource Groups Tagging API.
+func NewBulkTagger(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI) tftags.BulkTagger {
+	return &bulkTagger{
+		conn: conn,
+	}
+}
+
+func (t *bulkTagger) TagResources(ctx context.Context, identifiers []string, tags tftags.KeyValueTags) (map[string]error, error) {
+	errs := make(map[string]error)
+
+	for _, tags := range tags.Chunks(bulkTagsChunkSize) {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: aws.StringSlice(identifiers),
+			Tags:            aws.StringMap(tags.Map()),
+		}
+
+		output, err := t.conn.TagResourcesWithContext(ctx, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("tagging resources: %w", err)
+		}
+
+		failedResources(output.FailedResourcesMap, errs)
+	}
+
+	return errs, nil
+}
+
+func (t *bulkTagger) UntagResources(ctx context.Context, identifiers []string, keys []string) (map[string]error, error) {
+	errs := make(map[string]error)
+
+	for _, keys := range tftags.New(ctx, keys).Chunks(bulkTagsChunkSize) {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: aws.StringSlice(identifiers),
+			TagKeys:         aws.StringSlice(keys.Keys()),
+		}
+
+		output, err := t.conn.UntagResourcesWithContext(ctx, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("untagging resources: %w", err)
+		}
+
+		failedResources(output.FailedResourcesMap, errs)
+	}
+
+	return errs, nil
+}
+
+// failedResources adds an error for each failed resource to errs.
+func failedResources(failed map[string]*resourcegroupstaggingapi.FailureInfo, errs map[string]error) {
+	for arn, v := range failed {
+		if v == nil {
+			continue
+		}
+
+		errs[arn] = fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage))
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -118,7 +119,7 @@ func resourceBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.S3, create.ErrActionReading, "Bucket Policy", d.Id(), errors.New("empty policy returned"))
 	}
 
-	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(pol.Policy))
+	policyToSet, err := tfpolicy.ToSet(d.Get("policy").(string), aws.StringValue(pol.Policy))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting policy: %s", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -116,7 +117,7 @@ func resourceAccessPointPolicyRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("has_public_access_policy", status.IsPublic)
 
 	if policy != "" {
-		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+		policyToSet, err := tfpolicy.ToSet(d.Get("policy").(string), policy)
 		if err != nil {
 			return diag.FromErr(err)
 		}
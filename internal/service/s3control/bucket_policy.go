@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -106,7 +107,7 @@ func resourceBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("bucket", d.Id())
 
 	if output.Policy != nil {
-		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+		policyToSet, err := tfpolicy.ToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -273,7 +274,7 @@ func flattenMultiRegionAccessPointPolicyDocument(name string, apiObject *s3contr
 			if old != nil {
 				if w, ok := old["policy"].(string); ok {
 					var err error
-					policyToSet, err = verify.PolicyToSet(w, aws.StringValue(v))
+					policyToSet, err = tfpolicy.ToSet(w, aws.StringValue(v))
 
 					if err != nil {
 						policyToSet = aws.StringValue(v)
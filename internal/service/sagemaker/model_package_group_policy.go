@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -91,7 +92,7 @@ func resourceModelPackageGroupPolicyRead(ctx context.Context, d *schema.Resource
 
 	d.Set("model_package_group_name", d.Id())
 
-	policyToSet, err := verify.PolicyToSet(d.Get("resource_policy").(string), aws.StringValue(mpg.ResourcePolicy))
+	policyToSet, err := tfpolicy.ToSet(d.Get("resource_policy").(string), aws.StringValue(mpg.ResourcePolicy))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading SageMaker Model Package Group Policy (%s): %s", d.Id(), err)
 	}
@@ -0,0 +1,949 @@
+package sfn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Offline validation of Amazon States Language (ASL) state machine definitions.
+// See https://states-language.net/spec.html.
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+const (
+	errorNameAll = "States.ALL"
+)
+
+// predefinedErrorNames are the error names, other than States.ALL, reserved by Step Functions.
+var predefinedErrorNames = map[string]struct{}{
+	"States.BranchFailed":                    {},
+	"States.DataLimitExceeded":               {},
+	"States.ExceedToleratedFailureThreshold": {},
+	"States.HeartbeatTimeout":                {},
+	"States.Http.Socket":                     {},
+	"States.IntrinsicFailure":                {},
+	"States.ItemReaderFailed":                {},
+	"States.NoChoiceMatched":                 {},
+	"States.ParameterPathFailure":            {},
+	"States.Permissions":                     {},
+	"States.ResultPathMatchFailure":          {},
+	"States.ResultWriterFailed":              {},
+	"States.Runtime":                         {},
+	"States.TaskFailed":                      {},
+	"States.Timeout":                         {},
+}
+
+// intrinsicFunctions are the names of the ASL intrinsic functions.
+var intrinsicFunctions = map[string]struct{}{
+	"States.Array":          {},
+	"States.ArrayContains":  {},
+	"States.ArrayGetItem":   {},
+	"States.ArrayLength":    {},
+	"States.ArrayPartition": {},
+	"States.ArrayRange":     {},
+	"States.ArrayUnique":    {},
+	"States.Base64Decode":   {},
+	"States.Base64Encode":   {},
+	"States.Format":         {},
+	"States.Hash":           {},
+	"States.JsonMerge":      {},
+	"States.JsonToString":   {},
+	"States.MathAdd":        {},
+	"States.MathRandom":     {},
+	"States.StringSplit":    {},
+	"States.StringToJson":   {},
+	"States.UUID":           {},
+}
+
+// choiceComparisonOperators are the data-test expression operators of Choice rules.
+var choiceComparisonOperators = func() map[string]struct{} {
+	operators := map[string]struct{}{
+		"IsBoolean":     {},
+		"IsNull":        {},
+		"IsNumeric":     {},
+		"IsPresent":     {},
+		"IsString":      {},
+		"IsTimestamp":   {},
+		"StringMatches": {},
+	}
+
+	for _, prefix := range []string{"Boolean", "Numeric", "String", "Timestamp"} {
+		comparisons := []string{"Equals"}
+		if prefix != "Boolean" {
+			comparisons = append(comparisons, "LessThan", "GreaterThan", "LessThanEquals", "GreaterThanEquals")
+		}
+
+		for _, comparison := range comparisons {
+			operators[prefix+comparison] = struct{}{}
+			operators[prefix+comparison+"Path"] = struct{}{}
+		}
+	}
+
+	return operators
+}()
+
+func choiceComparisonOperator_Values() []string {
+	operators := make([]string, 0, len(choiceComparisonOperators))
+	for k := range choiceComparisonOperators {
+		operators = append(operators, k)
+	}
+	sort.Strings(operators)
+
+	return operators
+}
+
+// validStateMachineDefinition validates an ASL state machine definition.
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, err := range validateDefinition(value) {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// validateDefinition returns all the problems found in an ASL state machine definition.
+func validateDefinition(definition string) []error {
+	var doc interface{}
+
+	dec := json.NewDecoder(strings.NewReader(definition))
+	dec.UseNumber()
+
+	if err := dec.Decode(&doc); err != nil {
+		return []error{fmt.Errorf("parsing definition: %w", err)}
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return []error{errors.New("parsing definition: unexpected data after top-level value")}
+	}
+
+	v := &definitionValidator{}
+	v.stateMachine("", doc)
+
+	return v.errs
+}
+
+type definitionValidator struct {
+	errs []error
+}
+
+func (v *definitionValidator) errorf(path, format string, a ...interface{}) {
+	if path == "" {
+		path = "definition"
+	}
+
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
+
+// stateMachine validates a top-level state machine or a Parallel branch or Map item processor.
+func (v *definitionValidator) stateMachine(path string, raw interface{}) {
+	m, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.errorf(path, "expected an object")
+		return
+	}
+
+	startAt, ok := m["StartAt"].(string)
+
+	if !ok || startAt == "" {
+		v.errorf(joinPath(path, "StartAt"), "a state name is required")
+	}
+
+	states, ok := m["States"].(map[string]interface{})
+
+	if !ok || len(states) == 0 {
+		v.errorf(joinPath(path, "States"), "at least one state is required")
+		return
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			v.errorf(joinPath(path, "StartAt"), "state %q does not exist", startAt)
+			startAt = ""
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	transitions := make(map[string][]string, len(states))
+	for _, name := range names {
+		transitions[name] = v.state(joinPath(path, "States."+name), states[name], states)
+	}
+
+	if startAt == "" {
+		return
+	}
+
+	reachable := map[string]bool{startAt: true}
+	for queue := []string{startAt}; len(queue) > 0; queue = queue[1:] {
+		for _, next := range transitions[queue[0]] {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(joinPath(path, "States."+name), "state is not reachable from StartAt (%s)", startAt)
+		}
+	}
+}
+
+// state validates a state and returns the names of the states that it can transition to.
+func (v *definitionValidator) state(path string, raw interface{}, states map[string]interface{}) []string {
+	m, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.errorf(path, "expected an object")
+		return nil
+	}
+
+	typ, _ := m["Type"].(string)
+
+	switch typ {
+	case stateTypeChoice, stateTypeFail, stateTypeMap, stateTypeParallel, stateTypePass, stateTypeSucceed, stateTypeTask, stateTypeWait:
+	case "":
+		v.errorf(joinPath(path, "Type"), "a state type is required")
+		return nil
+	default:
+		v.errorf(joinPath(path, "Type"), "unsupported state type %q, expected one of %s", typ, strings.Join(stateType_Values(), ", "))
+		return nil
+	}
+
+	var next []string
+	target := func(path string, raw interface{}) {
+		name, ok := raw.(string)
+
+		switch {
+		case raw == nil:
+			v.errorf(path, "a state name is required")
+		case !ok || name == "":
+			v.errorf(path, "expected a state name")
+		default:
+			if _, ok := states[name]; !ok {
+				v.errorf(path, "state %q does not exist", name)
+			} else {
+				next = append(next, name)
+			}
+		}
+	}
+
+	_, hasNext := m["Next"]
+	end, hasEnd := m["End"]
+
+	switch typ {
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		if hasNext || hasEnd {
+			v.errorf(path, "%s states cannot have Next or End", typ)
+		}
+	default:
+		if _, ok := end.(bool); hasEnd && !ok {
+			v.errorf(joinPath(path, "End"), "expected a boolean")
+		}
+
+		switch {
+		case hasNext && end == true:
+			v.errorf(path, "only one of Next or End can be set")
+		case hasNext:
+			target(joinPath(path, "Next"), m["Next"])
+		case end != true:
+			v.errorf(path, "one of Next or End = true is required")
+		}
+	}
+
+	for _, field := range []string{"InputPath", "OutputPath"} {
+		if raw, ok := m[field]; ok {
+			v.optionalPath(joinPath(path, field), raw, false)
+		}
+	}
+
+	if raw, ok := m["ResultPath"]; ok {
+		v.optionalPath(joinPath(path, "ResultPath"), raw, true)
+	}
+
+	for _, field := range []string{"ItemSelector", "Parameters", "ResultSelector"} {
+		if raw, ok := m[field]; ok {
+			v.payloadTemplate(joinPath(path, field), raw)
+		}
+	}
+
+	for _, field := range []string{"Retry", "Catch"} {
+		if _, ok := m[field]; !ok {
+			continue
+		}
+
+		switch typ {
+		case stateTypeMap, stateTypeParallel, stateTypeTask:
+		default:
+			v.errorf(joinPath(path, field), "%s states do not support %s", typ, field)
+			continue
+		}
+
+		if field == "Retry" {
+			v.retriers(joinPath(path, field), m[field])
+		} else {
+			v.catchers(joinPath(path, field), m[field], target)
+		}
+	}
+
+	switch typ {
+	case stateTypeChoice:
+		v.choiceState(path, m, target)
+	case stateTypeFail:
+		for _, field := range []string{"CausePath", "ErrorPath"} {
+			if raw, ok := m[field]; ok {
+				v.pathOrIntrinsicFunction(joinPath(path, field), raw)
+			}
+		}
+	case stateTypeMap:
+		if raw, ok := m["ItemsPath"]; ok {
+			v.optionalPath(joinPath(path, "ItemsPath"), raw, true)
+		}
+
+		switch processor, iterator := m["ItemProcessor"], m["Iterator"]; {
+		case processor != nil && iterator != nil:
+			v.errorf(path, "only one of ItemProcessor or Iterator can be set")
+		case processor != nil:
+			v.stateMachine(joinPath(path, "ItemProcessor"), processor)
+		case iterator != nil:
+			v.stateMachine(joinPath(path, "Iterator"), iterator)
+		default:
+			v.errorf(path, "one of ItemProcessor or Iterator is required")
+		}
+	case stateTypeParallel:
+		branches, ok := m["Branches"].([]interface{})
+
+		if !ok || len(branches) == 0 {
+			v.errorf(joinPath(path, "Branches"), "at least one branch is required")
+		}
+
+		for i, branch := range branches {
+			v.stateMachine(fmt.Sprintf("%s.Branches[%d]", path, i), branch)
+		}
+	case stateTypeTask:
+		if resource, ok := m["Resource"].(string); !ok || resource == "" {
+			v.errorf(joinPath(path, "Resource"), "a resource ARN is required")
+		}
+
+		for _, field := range []string{"HeartbeatSecondsPath", "TimeoutSecondsPath"} {
+			if raw, ok := m[field]; ok {
+				v.optionalPath(joinPath(path, field), raw, true)
+			}
+		}
+	case stateTypeWait:
+		var fields []string
+		for _, field := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := m[field]; ok {
+				fields = append(fields, field)
+			}
+		}
+
+		if len(fields) != 1 {
+			v.errorf(path, "exactly one of Seconds, SecondsPath, Timestamp or TimestampPath is required")
+		}
+
+		for _, field := range []string{"SecondsPath", "TimestampPath"} {
+			if raw, ok := m[field]; ok {
+				v.optionalPath(joinPath(path, field), raw, true)
+			}
+		}
+
+		if raw, ok := m["Timestamp"]; ok {
+			v.timestamp(joinPath(path, "Timestamp"), raw)
+		}
+	}
+
+	return next
+}
+
+func (v *definitionValidator) choiceState(path string, m map[string]interface{}, target func(string, interface{})) {
+	rules, ok := m["Choices"].([]interface{})
+
+	if !ok || len(rules) == 0 {
+		v.errorf(joinPath(path, "Choices"), "at least one choice rule is required")
+	}
+
+	for i, rule := range rules {
+		rulePath := fmt.Sprintf("%s.Choices[%d]", path, i)
+		v.choiceRule(rulePath, rule, true)
+
+		if rule, ok := rule.(map[string]interface{}); ok {
+			target(joinPath(rulePath, "Next"), rule["Next"])
+		}
+	}
+
+	if raw, ok := m["Default"]; ok {
+		target(joinPath(path, "Default"), raw)
+	}
+}
+
+func (v *definitionValidator) choiceRule(path string, raw interface{}, topLevel bool) {
+	m, ok := raw.(map[string]interface{})
+
+	if !ok {
+		v.errorf(path, "expected a choice rule object")
+		return
+	}
+
+	if _, ok := m["Next"]; ok && !topLevel {
+		v.errorf(joinPath(path, "Next"), "Next is only allowed in top-level choice rules")
+	}
+
+	var operators []string
+	for k := range m {
+		if _, ok := choiceComparisonOperators[k]; ok || k == "And" || k == "Or" || k == "Not" {
+			operators = append(operators, k)
+		}
+	}
+	sort.Strings(operators)
+
+	switch len(operators) {
+	case 0:
+		v.errorf(path, "a comparison operator or one of And, Or or Not is required")
+		return
+	case 1:
+	default:
+		v.errorf(path, "only one comparison operator or one of And, Or or Not can be set, got %s", strings.Join(operators, ", "))
+		return
+	}
+
+	operator := operators[0]
+	operatorPath := joinPath(path, operator)
+
+	switch operator {
+	case "And", "Or":
+		rules, ok := m[operator].([]interface{})
+
+		if !ok || len(rules) == 0 {
+			v.errorf(operatorPath, "at least one choice rule is required")
+		}
+
+		for i, rule := range rules {
+			v.choiceRule(fmt.Sprintf("%s[%d]", operatorPath, i), rule, false)
+		}
+	case "Not":
+		v.choiceRule(operatorPath, m[operator], false)
+	default:
+		if variable, ok := m["Variable"]; ok {
+			v.path(joinPath(path, "Variable"), variable, false)
+		} else {
+			v.errorf(path, "Variable is required with %s", operator)
+		}
+
+		value := m[operator]
+
+		switch {
+		case strings.HasSuffix(operator, "Path"):
+			v.path(operatorPath, value, false)
+		case strings.HasPrefix(operator, "Boolean"), strings.HasPrefix(operator, "Is"):
+			if _, ok := value.(bool); !ok {
+				v.errorf(operatorPath, "expected a boolean")
+			}
+		case strings.HasPrefix(operator, "Numeric"):
+			if _, ok := value.(json.Number); !ok {
+				v.errorf(operatorPath, "expected a number")
+			}
+		case strings.HasPrefix(operator, "Timestamp"):
+			v.timestamp(operatorPath, value)
+		default:
+			if _, ok := value.(string); !ok {
+				v.errorf(operatorPath, "expected a string")
+			}
+		}
+	}
+}
+
+func (v *definitionValidator) retriers(path string, raw interface{}) {
+	retriers, ok := raw.([]interface{})
+
+	if !ok {
+		v.errorf(path, "expected an array of retriers")
+		return
+	}
+
+	for i, retrier := range retriers {
+		retrierPath := fmt.Sprintf("%s[%d]", path, i)
+		m, ok := retrier.(map[string]interface{})
+
+		if !ok {
+			v.errorf(retrierPath, "expected a retrier object")
+			continue
+		}
+
+		v.errorEquals(retrierPath, m["ErrorEquals"], "retrier", i == len(retriers)-1)
+
+		if raw, ok := m["MaxAttempts"]; ok {
+			if n, err := integer(raw); err != nil || n < 0 {
+				v.errorf(joinPath(retrierPath, "MaxAttempts"), "expected a non-negative integer")
+			}
+		}
+
+		if raw, ok := m["IntervalSeconds"]; ok {
+			if n, err := integer(raw); err != nil || n < 1 {
+				v.errorf(joinPath(retrierPath, "IntervalSeconds"), "expected a positive integer")
+			}
+		}
+
+		if raw, ok := m["BackoffRate"]; ok {
+			if n, ok := raw.(json.Number); !ok {
+				v.errorf(joinPath(retrierPath, "BackoffRate"), "expected a number")
+			} else if f, err := n.Float64(); err != nil || f < 1.0 {
+				v.errorf(joinPath(retrierPath, "BackoffRate"), "expected a number greater than or equal to 1.0")
+			}
+		}
+	}
+}
+
+func (v *definitionValidator) catchers(path string, raw interface{}, target func(string, interface{})) {
+	catchers, ok := raw.([]interface{})
+
+	if !ok {
+		v.errorf(path, "expected an array of catchers")
+		return
+	}
+
+	for i, catcher := range catchers {
+		catcherPath := fmt.Sprintf("%s[%d]", path, i)
+		m, ok := catcher.(map[string]interface{})
+
+		if !ok {
+			v.errorf(catcherPath, "expected a catcher object")
+			continue
+		}
+
+		v.errorEquals(catcherPath, m["ErrorEquals"], "catcher", i == len(catchers)-1)
+		target(joinPath(catcherPath, "Next"), m["Next"])
+
+		if raw, ok := m["ResultPath"]; ok {
+			v.optionalPath(joinPath(catcherPath, "ResultPath"), raw, true)
+		}
+	}
+}
+
+func (v *definitionValidator) errorEquals(path string, raw interface{}, kind string, last bool) {
+	path = joinPath(path, "ErrorEquals")
+	names, ok := raw.([]interface{})
+
+	if !ok || len(names) == 0 {
+		v.errorf(path, "at least one error name is required")
+		return
+	}
+
+	for _, raw := range names {
+		name, ok := raw.(string)
+
+		switch {
+		case !ok || name == "":
+			v.errorf(path, "expected an error name")
+		case name == errorNameAll:
+			if len(names) != 1 {
+				v.errorf(path, "%s must appear alone", errorNameAll)
+			}
+			if !last {
+				v.errorf(path, "%s must appear in the last %s", errorNameAll, kind)
+			}
+		case strings.HasPrefix(name, "States."):
+			if _, ok := predefinedErrorNames[name]; !ok {
+				v.errorf(path, "unknown predefined error name %q", name)
+			}
+		}
+	}
+}
+
+// payloadTemplate validates the fields whose names end in ".$" in Parameters, ResultSelector and ItemSelector.
+func (v *definitionValidator) payloadTemplate(path string, raw interface{}) {
+	switch raw := raw.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(raw))
+		for k := range raw {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			value := raw[k]
+
+			if strings.HasSuffix(k, ".$") {
+				v.pathOrIntrinsicFunction(joinPath(path, k), value)
+			} else {
+				v.payloadTemplate(joinPath(path, k), value)
+			}
+		}
+	case []interface{}:
+		for i, value := range raw {
+			v.payloadTemplate(fmt.Sprintf("%s[%d]", path, i), value)
+		}
+	}
+}
+
+func (v *definitionValidator) pathOrIntrinsicFunction(path string, raw interface{}) {
+	s, ok := raw.(string)
+
+	switch {
+	case !ok:
+		v.errorf(path, "expected a path or intrinsic function")
+	case strings.HasPrefix(s, "$"):
+		v.path(path, s, false)
+	case strings.HasPrefix(s, "States."):
+		if err := validIntrinsicFunction(s); err != nil {
+			v.errorf(path, "invalid intrinsic function (%s): %s", s, err)
+		}
+	default:
+		v.errorf(path, "%q is neither a path nor an intrinsic function", s)
+	}
+}
+
+// optionalPath validates a path field that can also be null.
+func (v *definitionValidator) optionalPath(path string, raw interface{}, reference bool) {
+	if raw == nil {
+		return
+	}
+
+	v.path(path, raw, reference)
+}
+
+func (v *definitionValidator) path(path string, raw interface{}, reference bool) {
+	s, ok := raw.(string)
+
+	if !ok {
+		v.errorf(path, "expected a path")
+		return
+	}
+
+	if err := validPath(s, reference); err != nil {
+		v.errorf(path, "invalid path (%s): %s", s, err)
+	}
+}
+
+func (v *definitionValidator) timestamp(path string, raw interface{}) {
+	s, ok := raw.(string)
+
+	if !ok {
+		v.errorf(path, "expected a timestamp")
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, s); err != nil {
+		v.errorf(path, "expected an RFC 3339 timestamp, got %q", s)
+	}
+}
+
+func integer(raw interface{}) (int64, error) {
+	n, ok := raw.(json.Number)
+
+	if !ok {
+		return 0, errors.New("expected a number")
+	}
+
+	return n.Int64()
+}
+
+// validPath returns an error if s is not a syntactically valid JSONPath or context object path.
+// Reference paths, such as ResultPath, can only identify a single node.
+func validPath(s string, reference bool) error {
+	switch {
+	case strings.HasPrefix(s, "$$"):
+		s = s[2:]
+	case strings.HasPrefix(s, "$"):
+		s = s[1:]
+	default:
+		return errors.New("must begin with $")
+	}
+
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+
+			if strings.HasPrefix(s, ".") {
+				if reference {
+					return errors.New("reference paths cannot use ..")
+				}
+				s = s[1:]
+			}
+
+			if strings.HasPrefix(s, "*") {
+				if reference {
+					return errors.New("reference paths cannot use *")
+				}
+				s = s[1:]
+				continue
+			}
+
+			n := strings.IndexAny(s, ".[")
+			if n == -1 {
+				n = len(s)
+			}
+
+			if n == 0 {
+				return errors.New("empty field name")
+			}
+
+			if strings.IndexFunc(s[:n], unicode.IsSpace) != -1 {
+				return fmt.Errorf("field name %q contains white space, use bracket notation", s[:n])
+			}
+
+			s = s[n:]
+		case '[':
+			n, err := closingBracket(s)
+			if err != nil {
+				return err
+			}
+
+			inner := strings.TrimSpace(s[1:n])
+
+			switch {
+			case inner == "":
+				return errors.New("empty []")
+			case reference && !referenceSubscript(inner):
+				return fmt.Errorf("reference paths can only use a single index or quoted name in [], got [%s]", inner)
+			}
+
+			s = s[n+1:]
+		default:
+			return fmt.Errorf("unexpected %q", s[0])
+		}
+	}
+
+	return nil
+}
+
+// closingBracket returns the index of the "]" that closes the "[" at the start of s.
+func closingBracket(s string) (int, error) {
+	var quote byte
+	depth := 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, errors.New("unterminated [")
+}
+
+func referenceSubscript(s string) bool {
+	if _, err := strconv.Atoi(s); err == nil {
+		return true
+	}
+
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return true
+	}
+
+	return false
+}
+
+// validIntrinsicFunction returns an error if s is not a syntactically valid intrinsic function call.
+func validIntrinsicFunction(s string) error {
+	p := &intrinsicFunctionParser{s: s}
+
+	if err := p.function(); err != nil {
+		return err
+	}
+
+	p.skipSpace()
+
+	if p.pos != len(p.s) {
+		return fmt.Errorf("unexpected %q at offset %d", p.s[p.pos:], p.pos)
+	}
+
+	return nil
+}
+
+type intrinsicFunctionParser struct {
+	s   string
+	pos int
+}
+
+func (p *intrinsicFunctionParser) skipSpace() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *intrinsicFunctionParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *intrinsicFunctionParser) function() error {
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] == '.' || unicode.IsLetter(rune(p.s[p.pos])) || unicode.IsDigit(rune(p.s[p.pos]))) {
+		p.pos++
+	}
+
+	name := p.s[start:p.pos]
+
+	if _, ok := intrinsicFunctions[name]; !ok {
+		return fmt.Errorf("unknown intrinsic function %q", name)
+	}
+
+	if !p.consume('(') {
+		return fmt.Errorf("expected ( after %s", name)
+	}
+
+	p.skipSpace()
+
+	if p.consume(')') {
+		return nil
+	}
+
+	for {
+		if err := p.argument(); err != nil {
+			return err
+		}
+
+		p.skipSpace()
+
+		switch {
+		case p.consume(','):
+			p.skipSpace()
+		case p.consume(')'):
+			return nil
+		default:
+			return fmt.Errorf("expected , or ) at offset %d in call to %s", p.pos, name)
+		}
+	}
+}
+
+func (p *intrinsicFunctionParser) argument() error {
+	if p.pos == len(p.s) {
+		return errors.New("unexpected end of function call")
+	}
+
+	switch c := p.s[p.pos]; {
+	case c == '\'':
+		for p.pos++; p.pos < len(p.s); p.pos++ {
+			switch p.s[p.pos] {
+			case '\\':
+				p.pos++
+			case '\'':
+				p.pos++
+				return nil
+			}
+		}
+
+		return errors.New("unterminated string literal")
+	case c == '$':
+		start := p.pos
+		depth := 0
+		var quote byte
+
+		for ; p.pos < len(p.s); p.pos++ {
+			c := p.s[p.pos]
+
+			if quote != 0 {
+				if c == '\\' {
+					p.pos++
+				} else if c == quote {
+					quote = 0
+				}
+				continue
+			}
+
+			if c == '\'' || c == '"' {
+				quote = c
+			} else if c == '[' {
+				depth++
+			} else if c == ']' {
+				depth--
+			} else if depth == 0 && (c == ',' || c == ')' || c == ' ') {
+				break
+			}
+		}
+
+		if err := validPath(p.s[start:p.pos], false); err != nil {
+			return fmt.Errorf("invalid path argument (%s): %s", p.s[start:p.pos], err)
+		}
+
+		return nil
+	case strings.HasPrefix(p.s[p.pos:], "States."):
+		return p.function()
+	default:
+		start := p.pos
+		for p.pos < len(p.s) && !strings.ContainsRune(",) ", rune(p.s[p.pos])) {
+			p.pos++
+		}
+
+		switch literal := p.s[start:p.pos]; literal {
+		case "null", "true", "false":
+			return nil
+		default:
+			if _, err := strconv.ParseFloat(literal, 64); err != nil {
+				return fmt.Errorf("invalid argument %q", literal)
+			}
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package sfn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition    string
+		expectedError string
+	}{
+		"valid": {
+			definition: `{
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Process"},
+        {"And": [{"Variable": "$.name", "IsPresent": true}, {"Not": {"Variable": "$.name", "StringEquals": ""}}], "Next": "Wait"}
+      ],
+      "Default": "Done"
+    },
+    "Wait": {"Type": "Wait", "SecondsPath": "$.delay", "Next": "Process"},
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "process",
+        "Payload": {"message.$": "States.Format('Hello, {}', $.name)", "items.$": "$.items[?(@.enabled == true)]", "ctx.$": "$$.Execution.Id"}
+      },
+      "ResultPath": "$.result",
+      "Retry": [
+        {"ErrorEquals": ["States.Timeout", "CustomError"], "MaxAttempts": 2, "IntervalSeconds": 1, "BackoffRate": 2.0},
+        {"ErrorEquals": ["States.ALL"]}
+      ],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "ResultPath": "$.error", "Next": "Failed"}],
+      "Next": "Fan out"
+    },
+    "Fan out": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemProcessor": {"StartAt": "B", "States": {"B": {"Type": "Succeed"}}},
+      "Next": "Done"
+    },
+    "Failed": {"Type": "Fail", "Error": "Failed"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition:    `{"StartAt": "A",`,
+			expectedError: "parsing definition",
+		},
+		"missing StartAt state": {
+			definition:    `{"StartAt": "B", "States": {"A": {"Type": "Succeed"}}}`,
+			expectedError: `StartAt: state "B" does not exist`,
+		},
+		"unknown state type": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Sleep", "End": true}}}`,
+			expectedError: `States.A.Type: unsupported state type "Sleep"`,
+		},
+		"missing Next and End": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			expectedError: "States.A: one of Next or End = true is required",
+		},
+		"Next and End": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			expectedError: "States.A: only one of Next or End can be set",
+		},
+		"Next to missing state": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+			expectedError: `States.A.Next: state "B" does not exist`,
+		},
+		"unreachable state": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Succeed"}, "B": {"Type": "Succeed"}}}`,
+			expectedError: "States.B: state is not reachable from StartAt (A)",
+		},
+		"Choice rule without operator": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedError: "States.A.Choices[0]: a comparison operator or one of And, Or or Not is required",
+		},
+		"Choice rule with two operators": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "StringEquals": "a", "IsPresent": true, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedError: "only one comparison operator",
+		},
+		"nested Choice rule with Next": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Not": {"Variable": "$.x", "IsNull": true, "Next": "B"}, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedError: "States.A.Choices[0].Not.Next: Next is only allowed in top-level choice rules",
+		},
+		"Choice rule wrong value type": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "NumericEquals": "1", "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedError: "States.A.Choices[0].NumericEquals: expected a number",
+		},
+		"States.ALL not alone": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Retry": [{"ErrorEquals": ["States.ALL", "States.Timeout"]}], "End": true}}}`,
+			expectedError: "States.A.Retry[0].ErrorEquals: States.ALL must appear alone",
+		},
+		"States.ALL not last": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Retry": [{"ErrorEquals": ["States.ALL"]}, {"ErrorEquals": ["States.Timeout"]}], "End": true}}}`,
+			expectedError: "States.A.Retry[0].ErrorEquals: States.ALL must appear in the last retrier",
+		},
+		"unknown predefined error": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Catch": [{"ErrorEquals": ["States.Timeut"], "Next": "B"}], "End": true}, "B": {"Type": "Succeed"}}}`,
+			expectedError: `unknown predefined error name "States.Timeut"`,
+		},
+		"Retry on Pass": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Retry": [], "End": true}}}`,
+			expectedError: "States.A.Retry: Pass states do not support Retry",
+		},
+		"invalid path": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "input", "End": true}}}`,
+			expectedError: "States.A.InputPath: invalid path (input): must begin with $",
+		},
+		"invalid reference path": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "ResultPath": "$.items[*]", "End": true}}}`,
+			expectedError: "States.A.ResultPath: invalid path",
+		},
+		"unknown intrinsic function": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"x.$": "States.Concat($.a, $.b)"}, "End": true}}}`,
+			expectedError: `unknown intrinsic function "States.Concat"`,
+		},
+		"unbalanced intrinsic function": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"x.$": "States.Format('{}', $.a"}, "End": true}}}`,
+			expectedError: "States.A.Parameters.x.$: invalid intrinsic function",
+		},
+		"payload template literal": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"x.$": "hello"}, "End": true}}}`,
+			expectedError: `"hello" is neither a path nor an intrinsic function`,
+		},
+		"nested branch": {
+			definition:    `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "B", "States": {"B": {"Type": "Pass", "Next": "A"}}}], "End": true}}}`,
+			expectedError: `States.A.Branches[0].States.B.Next: state "A" does not exist`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validateDefinition(testCase.definition)
+
+			if testCase.expectedError == "" {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+
+				return
+			}
+
+			for _, err := range errs {
+				if strings.Contains(err.Error(), testCase.expectedError) {
+					return
+				}
+			}
+
+			t.Fatalf("expected error containing %q, got %v", testCase.expectedError, errs)
+		})
+	}
+}
+
+func TestValidIntrinsicFunction(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"States.UUID()",
+		"States.Format('Hello, {}', $.name)",
+		"States.Format('It\\'s {}', States.JsonToString($.obj))",
+		"States.ArrayRange(1, 9, 2)",
+		"States.ArrayGetItem($.items['a,b'], 0)",
+		"States.Array(null, true, 'x', $$.Execution.Id)",
+	}
+	for _, v := range valid {
+		if err := validIntrinsicFunction(v); err != nil {
+			t.Errorf("%q should be a valid intrinsic function: %s", v, err)
+		}
+	}
+
+	invalid := []string{
+		"States.UUID",
+		"States.Unknown()",
+		"States.Format('unterminated)",
+		"States.Format('{}' $.a)",
+		"States.MathAdd(1, two)",
+		"States.UUID() extra",
+	}
+	for _, v := range invalid {
+		if err := validIntrinsicFunction(v); err == nil {
+			t.Errorf("%q should be an invalid intrinsic function", v)
+		}
+	}
+}
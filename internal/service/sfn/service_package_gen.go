@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
 		},
+		{
+			Factory:  DataSourceStateMachineDefinition,
+			TypeName: "aws_sfn_state_machine_definition",
+		},
 	}
 }
 
@@ -0,0 +1,499 @@
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition")
+func DataSourceStateMachineDefinition() *schema.Resource {
+	errorEquals := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_at": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branches": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+						},
+						"catch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_equals": errorEquals,
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"result_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"cause": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"choice": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(choiceComparisonOperator_Values(), false),
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"rule": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"variable": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"heartbeat_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"input_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item_processor": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"items_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 80),
+						},
+						"next": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"output_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"result_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"retry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backoff_rate": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1.0),
+									},
+									"error_equals": errorEquals,
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"max_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"seconds_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timestamp_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stateType_Values(), false),
+						},
+					},
+				},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &stateMachineDefinition{
+		Comment:        d.Get("comment").(string),
+		StartAt:        d.Get("start_at").(string),
+		States:         make(map[string]*stateMachineState),
+		TimeoutSeconds: d.Get("timeout_seconds").(int),
+		Version:        d.Get("version").(string),
+	}
+
+	for i, tfMapRaw := range d.Get("state").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := doc.States[name]; ok {
+			return sdkdiag.AppendErrorf(diags, "building Step Functions State Machine definition: state[%d]: duplicate state name %q", i, name)
+		}
+
+		state, err := expandStateMachineState(tfMap)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building Step Functions State Machine definition: state[%d] (%s): %s", i, name, err)
+		}
+
+		doc.States[name] = state
+	}
+
+	output, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building Step Functions State Machine definition: %s", err)
+	}
+
+	jsonString := string(output)
+
+	if errs := validateDefinition(jsonString); len(errs) > 0 {
+		for _, err := range errs {
+			diags = sdkdiag.AppendErrorf(diags, "building Step Functions State Machine definition: %s", err)
+		}
+
+		return diags
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+type stateMachineDefinition struct {
+	Comment        string                        `json:",omitempty"`
+	StartAt        string                        `json:"StartAt"`
+	States         map[string]*stateMachineState `json:"States"`
+	TimeoutSeconds int                           `json:",omitempty"`
+	Version        string                        `json:",omitempty"`
+}
+
+type stateMachineState struct {
+	Type             string                   `json:"Type"`
+	Comment          string                   `json:",omitempty"`
+	Resource         string                   `json:",omitempty"`
+	InputPath        string                   `json:",omitempty"`
+	Parameters       json.RawMessage          `json:",omitempty"`
+	Result           json.RawMessage          `json:",omitempty"`
+	ResultSelector   json.RawMessage          `json:",omitempty"`
+	ResultPath       string                   `json:",omitempty"`
+	OutputPath       string                   `json:",omitempty"`
+	TimeoutSeconds   int                      `json:",omitempty"`
+	HeartbeatSeconds int                      `json:",omitempty"`
+	Seconds          *int                     `json:",omitempty"`
+	SecondsPath      string                   `json:",omitempty"`
+	Timestamp        string                   `json:",omitempty"`
+	TimestampPath    string                   `json:",omitempty"`
+	Choices          []map[string]interface{} `json:",omitempty"`
+	Default          string                   `json:",omitempty"`
+	Branches         []json.RawMessage        `json:",omitempty"`
+	ItemsPath        string                   `json:",omitempty"`
+	MaxConcurrency   int                      `json:",omitempty"`
+	ItemProcessor    json.RawMessage          `json:",omitempty"`
+	Error            string                   `json:",omitempty"`
+	Cause            string                   `json:",omitempty"`
+	Retry            []*stateMachineRetrier   `json:",omitempty"`
+	Catch            []*stateMachineCatcher   `json:",omitempty"`
+	Next             string                   `json:",omitempty"`
+	End              bool                     `json:",omitempty"`
+}
+
+type stateMachineRetrier struct {
+	ErrorEquals     []string `json:"ErrorEquals"`
+	IntervalSeconds int      `json:",omitempty"`
+	MaxAttempts     int      `json:",omitempty"`
+	BackoffRate     float64  `json:",omitempty"`
+}
+
+type stateMachineCatcher struct {
+	ErrorEquals []string `json:"ErrorEquals"`
+	ResultPath  string   `json:",omitempty"`
+	Next        string   `json:"Next"`
+}
+
+func expandStateMachineState(tfMap map[string]interface{}) (*stateMachineState, error) {
+	state := &stateMachineState{
+		Type:             tfMap["type"].(string),
+		Comment:          tfMap["comment"].(string),
+		Resource:         tfMap["resource"].(string),
+		InputPath:        tfMap["input_path"].(string),
+		ResultPath:       tfMap["result_path"].(string),
+		OutputPath:       tfMap["output_path"].(string),
+		TimeoutSeconds:   tfMap["timeout_seconds"].(int),
+		HeartbeatSeconds: tfMap["heartbeat_seconds"].(int),
+		SecondsPath:      tfMap["seconds_path"].(string),
+		Timestamp:        tfMap["timestamp"].(string),
+		TimestampPath:    tfMap["timestamp_path"].(string),
+		Default:          tfMap["default"].(string),
+		ItemsPath:        tfMap["items_path"].(string),
+		MaxConcurrency:   tfMap["max_concurrency"].(int),
+		Error:            tfMap["error"].(string),
+		Cause:            tfMap["cause"].(string),
+		Next:             tfMap["next"].(string),
+		End:              tfMap["end"].(bool),
+	}
+
+	if state.Type == stateTypeWait && state.SecondsPath == "" && state.Timestamp == "" && state.TimestampPath == "" {
+		v := tfMap["seconds"].(int)
+		state.Seconds = &v
+	}
+
+	for _, v := range []struct {
+		key   string
+		field *json.RawMessage
+	}{
+		{"item_processor", &state.ItemProcessor},
+		{"parameters", &state.Parameters},
+		{"result", &state.Result},
+		{"result_selector", &state.ResultSelector},
+	} {
+		if s := tfMap[v.key].(string); s != "" {
+			*v.field = json.RawMessage(s)
+		}
+	}
+
+	for _, v := range tfMap["branches"].([]interface{}) {
+		if s, ok := v.(string); ok && s != "" {
+			state.Branches = append(state.Branches, json.RawMessage(s))
+		}
+	}
+
+	for i, tfMapRaw := range tfMap["choice"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule, err := expandStateMachineChoiceRule(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("choice[%d]: %w", i, err)
+		}
+
+		state.Choices = append(state.Choices, rule)
+	}
+
+	for _, tfMapRaw := range tfMap["retry"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		state.Retry = append(state.Retry, &stateMachineRetrier{
+			ErrorEquals:     flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+			IntervalSeconds: tfMap["interval_seconds"].(int),
+			MaxAttempts:     tfMap["max_attempts"].(int),
+			BackoffRate:     tfMap["backoff_rate"].(float64),
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["catch"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		state.Catch = append(state.Catch, &stateMachineCatcher{
+			ErrorEquals: flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+			ResultPath:  tfMap["result_path"].(string),
+			Next:        tfMap["next"].(string),
+		})
+	}
+
+	return state, nil
+}
+
+// expandStateMachineChoiceRule returns a top-level Choice rule from either its JSON `rule` or its `variable`, `comparison` and `value`.
+func expandStateMachineChoiceRule(tfMap map[string]interface{}) (map[string]interface{}, error) {
+	rule := make(map[string]interface{})
+	comparison, variable, value := tfMap["comparison"].(string), tfMap["variable"].(string), tfMap["value"].(string)
+
+	if v := tfMap["rule"].(string); v != "" {
+		if comparison != "" || variable != "" || value != "" {
+			return nil, fmt.Errorf("only one of rule or comparison, variable and value can be set")
+		}
+
+		if err := json.Unmarshal([]byte(v), &rule); err != nil {
+			return nil, fmt.Errorf("rule: %w", err)
+		}
+	} else {
+		if comparison == "" || variable == "" {
+			return nil, fmt.Errorf("one of rule or comparison and variable is required")
+		}
+
+		rule["Variable"] = variable
+
+		switch {
+		case strings.HasSuffix(comparison, "Path"):
+			rule[comparison] = value
+		case strings.HasPrefix(comparison, "Boolean"), strings.HasPrefix(comparison, "Is"):
+			v, err := strconv.ParseBool(value)
+
+			if err != nil {
+				return nil, fmt.Errorf("value: %s requires true or false, got %q", comparison, value)
+			}
+
+			rule[comparison] = v
+		case strings.HasPrefix(comparison, "Numeric"):
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("value: %s requires a number, got %q", comparison, value)
+			}
+
+			rule[comparison] = json.Number(value)
+		default:
+			rule[comparison] = value
+		}
+	}
+
+	rule["Next"] = tfMap["next"].(string)
+
+	return rule, nil
+}
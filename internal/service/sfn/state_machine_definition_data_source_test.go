@@ -0,0 +1,149 @@
+package sfn_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNStateMachineDefinitionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccStateMachineDefinitionDataSourceConfig_basic_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_invalid,
+				ExpectError: regexp.MustCompile(`States.Wait.Next: state "Done" does not exist`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition" "test" {
+  comment  = "test"
+  start_at = "Check"
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Wait"
+
+    choice {
+      variable   = "$.count"
+      comparison = "NumericGreaterThan"
+      value      = "10"
+      next       = "Done"
+    }
+
+    choice {
+      rule = jsonencode({ "Not" = { "Variable" = "$.name", "IsPresent" = true } })
+      next = "Failed"
+    }
+  }
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    seconds = 5
+    next    = "Process"
+  }
+
+  state {
+    name        = "Process"
+    type        = "Task"
+    resource    = "arn:aws:states:::lambda:invoke"
+    parameters  = jsonencode({ "FunctionName" = "test", "Payload.$" = "$" })
+    result_path = "$.result"
+    next        = "Done"
+
+    retry {
+      error_equals     = ["States.TaskFailed"]
+      interval_seconds = 2
+      max_attempts     = 3
+      backoff_rate     = 2
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Failed"
+    }
+  }
+
+  state {
+    name  = "Failed"
+    type  = "Fail"
+    error = "Failed"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_basic_ExpectedJSON = `{
+  "Comment": "test",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Done"},
+        {"Not": {"Variable": "$.name", "IsPresent": true}, "Next": "Failed"}
+      ],
+      "Default": "Wait"
+    },
+    "Wait": {"Type": "Wait", "Seconds": 5, "Next": "Process"},
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "test", "Payload.$": "$"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.TaskFailed"], "IntervalSeconds": 2, "MaxAttempts": 3, "BackoffRate": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "Next": "Done"
+    },
+    "Failed": {"Type": "Fail", "Error": "Failed"},
+    "Done": {"Type": "Succeed"}
+  }
+}`
+
+const testAccStateMachineDefinitionDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Wait"
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    seconds = 5
+    next    = "Done"
+  }
+}
+`
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -104,7 +105,7 @@ func resourceTopicPolicyRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", attributes[TopicAttributeNameTopicARN])
 	d.Set("owner", attributes[TopicAttributeNameOwner])
 
-	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+	policyToSet, err := tfpolicy.ToSet(d.Get("policy").(string), policy)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 type queueAttributeHandler struct {
@@ -77,7 +77,7 @@ func (h *queueAttributeHandler) Read(ctx context.Context, d *schema.ResourceData
 	}
 
 	if h.SchemaKey == "policy" {
-		newValue, err = verify.PolicyToSet(d.Get("policy").(string), newValue)
+		newValue, err = tfpolicy.ToSet(d.Get("policy").(string), newValue)
 		if err != nil {
 			return diag.FromErr(err)
 		}
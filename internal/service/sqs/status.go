@@ -5,8 +5,8 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/service/sqs"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -48,7 +48,7 @@ func statusQueueAttributeState(ctx context.Context, conn *sqs.SQS, url string, e
 
 				switch k {
 				case sqs.QueueAttributeNamePolicy:
-					equivalent, err := awspolicy.PoliciesAreEquivalent(g, e)
+					equivalent, err := tfpolicy.Equivalent(g, e)
 
 					if err != nil {
 						return queueAttributeStateNotEqual
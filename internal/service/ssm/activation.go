@@ -20,7 +20,7 @@ import (
 )
 
 // @SDKResource("aws_ssm_activation", name="Activation")
-// @Tags
+// @Tags(createOnly=true, createOperation="CreateActivation", createTagsField="Tags")
 func ResourceActivation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceActivationCreate,
@@ -71,7 +71,7 @@ func ResourceActivation() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			names.AttrTags:    tftags.TagsSchemaForceNew(),
+			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
@@ -87,7 +87,6 @@ func resourceActivationCreate(ctx context.Context, d *schema.ResourceData, meta
 	input := &ssm.CreateActivationInput{
 		DefaultInstanceName: aws.String(name),
 		IamRole:             aws.String(d.Get("name").(string)),
-		Tags:                GetTagsIn(ctx),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
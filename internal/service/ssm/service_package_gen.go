@@ -54,7 +54,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceActivation,
 			TypeName: "aws_ssm_activation",
 			Name:     "Activation",
-			Tags:     &types.ServicePackageResourceTags{},
+			Tags: &types.ServicePackageResourceTags{
+				CreateOnly:      true,
+				CreateOperation: "CreateActivation",
+				CreateTagsField: "Tags",
+			},
 		},
 		{
 			Factory:  ResourceAssociation,
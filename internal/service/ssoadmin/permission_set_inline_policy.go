@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -119,7 +120,7 @@ func resourcePermissionSetInlinePolicyRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "reading Inline Policy for SSO Permission Set (%s): empty output", permissionSetArn)
 	}
 
-	policyToSet, err := verify.PolicyToSet(d.Get("inline_policy").(string), aws.StringValue(output.InlinePolicy))
+	policyToSet, err := tfpolicy.ToSet(d.Get("inline_policy").(string), aws.StringValue(output.InlinePolicy))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Inline Policy for SSO Permission Set (%s): %s", permissionSetArn, err)
@@ -0,0 +1,358 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// creationTimeAttributes are the names of attributes commonly holding a resource's RFC 3339 creation timestamp.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"creation_timestamp",
+}
+
+// Filters limit what sweepers delete.
+// Filters are configured from environment variables and applied centrally to every swept resource.
+type Filters struct {
+	DryRun        bool
+	MinAge        time.Duration
+	ProtectedTags map[string]string // An empty value matches any value.
+}
+
+// FiltersFromEnv returns the Filters configured from environment variables.
+func FiltersFromEnv() (*Filters, error) {
+	filters := &Filters{
+		ProtectedTags: make(map[string]string),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		filters.DryRun = b
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		filters.MinAge = d
+	}
+
+	for _, v := range strings.Split(os.Getenv(envvar.SweepProtectedTags), ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(v, "=")
+		filters.ProtectedTags[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return filters, nil
+}
+
+// enabled returns whether swept resources must be described before deletion.
+func (f *Filters) enabled() bool {
+	return f.restrictive() || os.Getenv(envvar.SweepReport) != ""
+}
+
+// restrictive returns whether the Filters may prevent resources from being deleted.
+// Sweepers that delete resources without going through the Filters must then be refused.
+func (f *Filters) restrictive() bool {
+	return f.DryRun || f.MinAge > 0 || len(f.ProtectedTags) > 0
+}
+
+// Exclude returns why the described resource must not be deleted, or an empty string.
+func (f *Filters) Exclude(entry *ReportEntry, now time.Time) string {
+	var reasons []string
+
+	for _, key := range sortedKeys(entry.Tags) {
+		want, ok := f.ProtectedTags[key]
+
+		if !ok || (want != "" && want != entry.Tags[key]) {
+			delete(entry.Tags, key)
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("protected by tag %s", key))
+	}
+
+	if f.MinAge > 0 && !entry.CreationTime.IsZero() {
+		if age := now.Sub(entry.CreationTime); age < f.MinAge {
+			reasons = append(reasons, fmt.Sprintf("created %s ago, less than %s", age.Round(time.Second), f.MinAge))
+		}
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+var (
+	filters     *Filters
+	filtersErr  error
+	filtersOnce sync.Once
+)
+
+// configuredFilters returns the Filters configured from environment variables.
+func configuredFilters() (*Filters, error) {
+	filtersOnce.Do(func() {
+		filters, filtersErr = FiltersFromEnv()
+	})
+
+	return filters, filtersErr
+}
+
+// deleteSweepable deletes a Sweepable unless it is excluded by the configured Filters.
+func deleteSweepable(ctx context.Context, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	filters, err := configuredFilters()
+
+	if err != nil {
+		return err
+	}
+
+	if !filters.enabled() {
+		return sweepable.Delete(conns.NewMutatingAPICallsPermittedContext(ctx), ThrottlingRetryTimeout, optFns...)
+	}
+
+	entry, err := describeSweepable(ctx, sweepable)
+
+	if err != nil {
+		// Never delete a resource that can't be checked against the filters.
+		return fmt.Errorf("reading %s (%s): %w", entry.ResourceType, entry.ID, err)
+	}
+
+	if entry == nil {
+		// Already deleted.
+		return nil
+	}
+
+	if reason := filters.Exclude(entry, time.Now()); reason != "" {
+		entry.Action = ActionExclude
+		entry.Reason = reason
+
+		return report(entry)
+	}
+
+	entry.Action = ActionDelete
+	entry.Tags = nil
+
+	if filters.DryRun {
+		entry.Reason = "dry run"
+
+		return report(entry)
+	}
+
+	if err := report(entry); err != nil {
+		return err
+	}
+
+	// Sweeper clients may refuse API calls that modify resources unless they are made from here.
+	return sweepable.Delete(conns.NewMutatingAPICallsPermittedContext(ctx), ThrottlingRetryTimeout, optFns...)
+}
+
+// describeSweepable reads a Sweepable's current tags and creation time.
+// Returns nil if the resource no longer exists.
+func describeSweepable(ctx context.Context, sweepable Sweepable) (*ReportEntry, error) {
+	switch v := sweepable.(type) {
+	case *SweepResource:
+		return v.describe(ctx)
+	case *SweepFrameworkResource:
+		return v.describe(ctx)
+	default:
+		return &ReportEntry{ResourceType: fmt.Sprintf("%T", sweepable)}, errors.New("filtering is not supported for this Sweepable type")
+	}
+}
+
+func (sr *SweepResource) describe(ctx context.Context) (*ReportEntry, error) {
+	entry := &ReportEntry{
+		ResourceType: sdkResourceTypeName(sr.resource),
+		ID:           sr.d.Id(),
+		Region:       region(sr.meta),
+	}
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return entry, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := sr.resource.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+			entry.Tags = make(map[string]string, len(v))
+			for k, v := range v {
+				entry.Tags[k], _ = v.(string)
+			}
+			break
+		}
+	}
+
+	for _, k := range creationTimeAttributes {
+		if _, ok := sr.resource.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(string); ok && v != "" {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				entry.CreationTime = t
+				break
+			}
+		}
+	}
+
+	return entry, nil
+}
+
+func (sr *SweepFrameworkResource) describe(ctx context.Context) (*ReportEntry, error) {
+	entry := &ReportEntry{
+		ID:     sr.id,
+		Region: region(sr.meta),
+	}
+
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return entry, err
+	}
+
+	var metadata fwresource.MetadataResponse
+	resource.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+	entry.ResourceType = metadata.TypeName
+
+	state, err := frameworkResourceState(ctx, resource, sr.id, sr.meta, sr.supplementalAttributes)
+
+	if err != nil {
+		return entry, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return entry, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		var tags fwtypes.Map
+
+		if diags := response.State.GetAttribute(ctx, path.Root(k), &tags); diags.HasError() || tags.IsNull() || tags.IsUnknown() {
+			continue
+		}
+
+		var m map[string]string
+		if diags := tags.ElementsAs(ctx, &m, false); !diags.HasError() && len(m) > 0 {
+			entry.Tags = m
+			break
+		}
+	}
+
+	for _, k := range creationTimeAttributes {
+		var v fwtypes.String
+
+		if diags := response.State.GetAttribute(ctx, path.Root(k), &v); diags.HasError() || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339, v.ValueString()); err == nil {
+			entry.CreationTime = t
+			break
+		}
+	}
+
+	return entry, nil
+}
+
+func region(meta interface{}) string {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		return v.Region
+	}
+
+	return ""
+}
+
+var (
+	sdkResourceTypeNames      = make(map[uintptr][]string)
+	sdkResourceTypeNamesMutex sync.RWMutex
+)
+
+// RegisterServicePackages registers the Terraform resource type names of the service packages' Plugin SDK resources.
+// The names are used to identify swept resources in reports.
+func RegisterServicePackages(ctx context.Context, servicePackages ...conns.ServicePackage) {
+	sdkResourceTypeNamesMutex.Lock()
+	defer sdkResourceTypeNamesMutex.Unlock()
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if pc := deleteHandler(v.Factory()); pc != 0 {
+				sdkResourceTypeNames[pc] = append(sdkResourceTypeNames[pc], v.TypeName)
+			}
+		}
+	}
+}
+
+// sdkResourceTypeName returns the Terraform resource type name of a Plugin SDK resource.
+// Resources are identified by their Delete handler. If the name was not registered or is ambiguous
+// the Delete handler's function name is returned.
+func sdkResourceTypeName(r *schema.Resource) string {
+	pc := deleteHandler(r)
+
+	sdkResourceTypeNamesMutex.RLock()
+	names := sdkResourceTypeNames[pc]
+	sdkResourceTypeNamesMutex.RUnlock()
+
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	if f := runtime.FuncForPC(pc); f != nil {
+		return f.Name()
+	}
+
+	return "unknown"
+}
+
+func deleteHandler(r *schema.Resource) uintptr {
+	switch {
+	case r.DeleteWithoutTimeout != nil:
+		return reflect.ValueOf(r.DeleteWithoutTimeout).Pointer()
+	case r.DeleteContext != nil:
+		return reflect.ValueOf(r.DeleteContext).Pointer()
+	case r.Delete != nil:
+		return reflect.ValueOf(r.Delete).Pointer()
+	}
+
+	return 0
+}
@@ -0,0 +1,177 @@
+package sweep_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestFiltersFromEnv(t *testing.T) {
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepMinAge, "2h")
+	t.Setenv(envvar.SweepProtectedTags, "DoNotDelete, Owner=platform")
+
+	filters, err := sweep.FiltersFromEnv()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &sweep.Filters{
+		DryRun:        true,
+		MinAge:        2 * time.Hour,
+		ProtectedTags: map[string]string{"DoNotDelete": "", "Owner": "platform"},
+	}
+
+	if diff := cmp.Diff(filters, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv(envvar.SweepMinAge, "two hours")
+
+	if _, err := sweep.FiltersFromEnv(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestFiltersExclude(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	filters := &sweep.Filters{
+		MinAge:        time.Hour,
+		ProtectedTags: map[string]string{"DoNotDelete": "", "Owner": "platform"},
+	}
+
+	testCases := []struct {
+		TestName       string
+		Entry          *sweep.ReportEntry
+		ExpectedReason string
+		ExpectedTags   map[string]string
+	}{
+		{
+			TestName: "no tags",
+			Entry:    &sweep.ReportEntry{},
+		},
+		{
+			TestName: "unprotected",
+			Entry: &sweep.ReportEntry{
+				Tags:         map[string]string{"Name": "tf-acc-test-1234", "Owner": "someone"},
+				CreationTime: now.Add(-2 * time.Hour),
+			},
+		},
+		{
+			TestName: "protected key",
+			Entry: &sweep.ReportEntry{
+				Tags: map[string]string{"Name": "tf-acc-test-1234", "DoNotDelete": "yes"},
+			},
+			ExpectedReason: "protected by tag DoNotDelete",
+			ExpectedTags:   map[string]string{"DoNotDelete": "yes"},
+		},
+		{
+			TestName: "protected key and value",
+			Entry: &sweep.ReportEntry{
+				Tags: map[string]string{"Owner": "platform"},
+			},
+			ExpectedReason: "protected by tag Owner",
+			ExpectedTags:   map[string]string{"Owner": "platform"},
+		},
+		{
+			TestName: "too new",
+			Entry: &sweep.ReportEntry{
+				CreationTime: now.Add(-10 * time.Minute),
+			},
+			ExpectedReason: "created 10m0s ago, less than 1h0m0s",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := filters.Exclude(testCase.Entry, now), testCase.ExpectedReason; got != want {
+				t.Errorf("reason = %q, want %q", got, want)
+			}
+
+			if testCase.ExpectedReason != "" {
+				if diff := cmp.Diff(testCase.Entry.Tags, testCase.ExpectedTags); diff != "" {
+					t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestReportWriter(t *testing.T) {
+	t.Parallel()
+
+	entries := []*sweep.ReportEntry{
+		{
+			Action:       sweep.ActionDelete,
+			Region:       "us-west-2", //lintignore:AWSAT003
+			ResourceType: "aws_vpc",
+			ID:           "vpc-12345678",
+			Reason:       "dry run",
+		},
+		{
+			Action:       sweep.ActionExclude,
+			Region:       "us-west-2", //lintignore:AWSAT003
+			ResourceType: "aws_subnet",
+			ID:           "subnet-12345678",
+			Reason:       "protected by tag DoNotDelete",
+			Tags:         map[string]string{"DoNotDelete": "yes"},
+		},
+	}
+
+	testCases := []struct {
+		TestName  string
+		CSVFormat bool
+		Expected  string
+	}{
+		{
+			TestName: "JSON",
+			Expected: `{"action":"delete","region":"us-west-2","resource_type":"aws_vpc","id":"vpc-12345678","reason":"dry run"}
+{"action":"exclude","region":"us-west-2","resource_type":"aws_subnet","id":"subnet-12345678","reason":"protected by tag DoNotDelete","tags":{"DoNotDelete":"yes"}}
+`,
+		},
+		{
+			TestName:  "CSV",
+			CSVFormat: true,
+			Expected: `action,region,resource_type,id,reason,tags
+delete,us-west-2,aws_vpc,vpc-12345678,dry run,
+exclude,us-west-2,aws_subnet,subnet-12345678,protected by tag DoNotDelete,DoNotDelete=yes
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+			w, err := sweep.NewReportWriter(&b, testCase.CSVFormat)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, entry := range entries {
+				if err := w.Write(entry); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if diff := cmp.Diff(b.String(), testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
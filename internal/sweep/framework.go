@@ -88,6 +88,20 @@ func deleteFrameworkResource(ctx context.Context, factory func(context.Context)
 		return err
 	}
 
+	state, err := frameworkResourceState(ctx, resource, id, meta, supplementalAttributes)
+
+	if err != nil {
+		return err
+	}
+
+	response := fwresource.DeleteResponse{}
+	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
+
+	return fwdiag.DiagnosticsError(response.Diagnostics)
+}
+
+// frameworkResourceState configures the resource and returns its state containing just the resource ID and any supplemental attributes.
+func frameworkResourceState(ctx context.Context, resource fwresource.ResourceWithConfigure, id string, meta interface{}, supplementalAttributes []FrameworkSupplementalAttribute) (tfsdk.State, error) {
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: meta}, &fwresource.ConfigureResponse{})
 
 	schemaResp := fwresource.SchemaResponse{}
@@ -104,12 +118,9 @@ func deleteFrameworkResource(ctx context.Context, factory func(context.Context)
 	for _, attr := range supplementalAttributes {
 		d := state.SetAttribute(ctx, path.Root(attr.Path), attr.Value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return state, fwdiag.DiagnosticsError(d)
 		}
 	}
 
-	response := fwresource.DeleteResponse{}
-	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
-
-	return fwdiag.DiagnosticsError(response.Diagnostics)
+	return state, nil
 }
@@ -0,0 +1,275 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const DefaultSweepConcurrency = 10
+
+// Lister returns the resources of a single resource type that are to be swept.
+type Lister func(ctx context.Context) ([]Sweepable, error)
+
+type plannedResourceType struct {
+	lister       Lister
+	dependencies []string
+}
+
+// SweepPlanner sweeps resource types in dependency order.
+// Resource types are swept in waves: a resource type is only swept once all the
+// resource types it depends on have been swept successfully.
+// Dependencies have the same meaning as resource.Sweeper Dependencies, i.e. the
+// dependencies of a resource type are swept before the resource type itself.
+type SweepPlanner struct {
+	concurrency   int
+	resourceTypes map[string]*plannedResourceType
+	names         []string
+}
+
+// NewSweepPlanner returns a new SweepPlanner that deletes at most concurrency resources at a time.
+func NewSweepPlanner(concurrency int) *SweepPlanner {
+	if concurrency < 1 {
+		concurrency = DefaultSweepConcurrency
+	}
+
+	return &SweepPlanner{
+		concurrency:   concurrency,
+		resourceTypes: make(map[string]*plannedResourceType),
+	}
+}
+
+// AddResourceType registers a resource type, e.g. "aws_vpc", and the resource types that must be swept before it.
+func (p *SweepPlanner) AddResourceType(name string, lister Lister, dependencies ...string) {
+	if _, ok := p.resourceTypes[name]; !ok {
+		p.names = append(p.names, name)
+	}
+
+	p.resourceTypes[name] = &plannedResourceType{
+		lister:       lister,
+		dependencies: dependencies,
+	}
+}
+
+// Plan returns the resource types grouped into waves.
+// All resource types in a wave can be swept concurrently once the previous waves have been swept.
+// Returns an error if a dependency is not registered or a dependency cycle is detected.
+func (p *SweepPlanner) Plan() ([][]string, error) {
+	g := depgraph.New()
+
+	for _, name := range p.names {
+		g.AddNode(name)
+	}
+
+	var errs *multierror.Error
+
+	for _, name := range p.names {
+		for _, dependency := range p.resourceTypes[name].dependencies {
+			if !g.HasNode(dependency) {
+				errs = multierror.Append(errs, fmt.Errorf("resource type %s depends on unknown resource type %s", name, dependency))
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A resource type's wave is one more than the latest wave of its dependencies.
+	levels := make(map[string]int)
+	var waves [][]string
+
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		level := 0
+		for _, dependency := range dependencies {
+			if v := levels[dependency] + 1; v > level {
+				level = v
+			}
+		}
+		levels[name] = level
+
+		if level == len(waves) {
+			waves = append(waves, nil)
+		}
+		waves[level] = append(waves[level], name)
+	}
+
+	for _, wave := range waves {
+		sort.Strings(wave)
+	}
+
+	return waves, nil
+}
+
+// SweepReport summarizes the results of a planned sweep.
+type SweepReport struct {
+	// Swept is the number of resources deleted for each successfully swept resource type.
+	Swept map[string]int
+	// Failed is the listing or deletion error for each resource type that failed.
+	Failed map[string]error
+	// Blocked lists, for each resource type not swept, the failed resource types it (transitively) depends on.
+	Blocked map[string][]string
+}
+
+// ErrorOrNil returns an error summarizing the failed and blocked resource types, or nil.
+func (r *SweepReport) ErrorOrNil() error {
+	if len(r.Failed) == 0 && len(r.Blocked) == 0 {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, name := range sortedKeys(r.Failed) {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping %s: %w", name, r.Failed[name]))
+	}
+
+	for _, name := range sortedKeys(r.Blocked) {
+		errs = multierror.Append(errs, fmt.Errorf("%s not swept: blocked by %s", name, strings.Join(r.Blocked[name], ", ")))
+	}
+
+	return errs
+}
+
+// Sweep sweeps all registered resource types wave by wave.
+// Resource types depending on a resource type that could not be swept are not swept.
+// Returns an error if the plan is invalid, otherwise the report's summary error.
+func (p *SweepPlanner) Sweep(ctx context.Context, optFns ...tfresource.OptionsFunc) (*SweepReport, error) {
+	waves, err := p.Plan()
+
+	if err != nil {
+		return nil, fmt.Errorf("planning sweep: %w", err)
+	}
+
+	report := &SweepReport{
+		Swept:   make(map[string]int),
+		Failed:  make(map[string]error),
+		Blocked: make(map[string][]string),
+	}
+	semaphore := make(chan struct{}, p.concurrency)
+
+	for i, wave := range waves {
+		log.Printf("[INFO] Sweeping wave %d of %d: %s", i+1, len(waves), strings.Join(wave, ", "))
+
+		var mutex sync.Mutex
+		var wg sync.WaitGroup
+
+		for _, name := range wave {
+			if blockers := p.blockers(report, name); len(blockers) > 0 {
+				log.Printf("[WARN] Skipping %s sweep: blocked by %s", name, strings.Join(blockers, ", "))
+				report.Blocked[name] = blockers
+				continue
+			}
+
+			name := name
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				n, err := p.sweepResourceType(ctx, semaphore, name, optFns...)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if err != nil {
+					report.Failed[name] = err
+				} else {
+					report.Swept[name] = n
+				}
+			}()
+		}
+
+		wg.Wait()
+	}
+
+	return report, report.ErrorOrNil()
+}
+
+// blockers returns the failed resource types blocking the specified resource type.
+func (p *SweepPlanner) blockers(report *SweepReport, name string) []string {
+	blockers := make(map[string]struct{})
+
+	for _, dependency := range p.resourceTypes[name].dependencies {
+		if _, ok := report.Failed[dependency]; ok {
+			blockers[dependency] = struct{}{}
+		}
+
+		for _, v := range report.Blocked[dependency] {
+			blockers[v] = struct{}{}
+		}
+	}
+
+	return sortedKeys(blockers)
+}
+
+func (p *SweepPlanner) sweepResourceType(ctx context.Context, semaphore chan struct{}, name string, optFns ...tfresource.OptionsFunc) (int, error) {
+	sweepables, err := p.resourceTypes[name].lister(ctx)
+
+	if SkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s sweep: %s", name, err)
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("listing: %w", err)
+	}
+
+	var g multierror.Group
+
+	for _, sweepable := range sweepables {
+		sweepable := sweepable
+
+		g.Go(func() error {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			defer func() { <-semaphore }()
+
+			return deleteSweepable(ctx, sweepable, optFns...)
+		})
+	}
+
+	if err := g.Wait().ErrorOrNil(); err != nil {
+		return 0, err
+	}
+
+	return len(sweepables), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
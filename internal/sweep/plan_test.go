@@ -0,0 +1,182 @@
+package sweep_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	f func() error
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	return s.f()
+}
+
+func TestSweepPlannerPlan(t *testing.T) {
+	t.Parallel()
+
+	lister := func(ctx context.Context) ([]sweep.Sweepable, error) { return nil, nil }
+
+	testCases := []struct {
+		TestName      string
+		ResourceTypes map[string][]string
+		ExpectedWaves [][]string
+		ExpectedError string
+	}{
+		{
+			TestName: "empty",
+		},
+		{
+			TestName: "VPC",
+			ResourceTypes: map[string][]string{
+				"aws_network_interface": nil,
+				"aws_security_group":    {"aws_network_interface"},
+				"aws_subnet":            {"aws_network_interface"},
+				"aws_vpc":               {"aws_security_group", "aws_subnet"},
+				"aws_s3_bucket":         nil,
+			},
+			ExpectedWaves: [][]string{
+				{"aws_network_interface", "aws_s3_bucket"},
+				{"aws_security_group", "aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			TestName: "unknown dependency",
+			ResourceTypes: map[string][]string{
+				"aws_vpc": {"aws_subnet"},
+			},
+			ExpectedError: "resource type aws_vpc depends on unknown resource type aws_subnet",
+		},
+		{
+			TestName: "cycle",
+			ResourceTypes: map[string][]string{
+				"a": {"b"},
+				"b": {"c"},
+				"c": {"a"},
+			},
+			ExpectedError: "dependency cycle",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			planner := sweep.NewSweepPlanner(0)
+
+			for name, dependencies := range testCase.ResourceTypes {
+				planner.AddResourceType(name, lister, dependencies...)
+			}
+
+			waves, err := planner.Plan()
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(waves, testCase.ExpectedWaves); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweepPlannerSweep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	planner := sweep.NewSweepPlanner(2)
+
+	var mutex sync.Mutex
+	var deleted []string
+	var active, maxActive int
+
+	sweepables := func(name string, n int, err error) sweep.Lister {
+		return func(ctx context.Context) ([]sweep.Sweepable, error) {
+			var sweepables []sweep.Sweepable
+
+			for i := 0; i < n; i++ {
+				sweepables = append(sweepables, testSweepable{f: func() error {
+					mutex.Lock()
+					active++
+					if active > maxActive {
+						maxActive = active
+					}
+					mutex.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					active--
+					deleted = append(deleted, name)
+
+					return err
+				}})
+			}
+
+			return sweepables, nil
+		}
+	}
+
+	planner.AddResourceType("aws_network_interface", sweepables("aws_network_interface", 5, nil))
+	planner.AddResourceType("aws_subnet", sweepables("aws_subnet", 2, nil), "aws_network_interface")
+	planner.AddResourceType("aws_vpc", sweepables("aws_vpc", 1, nil), "aws_subnet", "aws_internet_gateway")
+	planner.AddResourceType("aws_internet_gateway", sweepables("aws_internet_gateway", 1, errors.New("DependencyViolation")))
+	planner.AddResourceType("aws_vpc_endpoint", sweepables("aws_vpc_endpoint", 1, nil), "aws_vpc")
+
+	report, err := planner.Sweep(ctx)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := report.Swept, map[string]int{"aws_network_interface": 5, "aws_subnet": 2}; !cmp.Equal(got, want) {
+		t.Errorf("swept = %v, want %v", got, want)
+	}
+
+	if _, ok := report.Failed["aws_internet_gateway"]; !ok || len(report.Failed) != 1 {
+		t.Errorf("failed = %v, want aws_internet_gateway", report.Failed)
+	}
+
+	if got, want := report.Blocked, map[string][]string{
+		"aws_vpc":          {"aws_internet_gateway"},
+		"aws_vpc_endpoint": {"aws_internet_gateway"},
+	}; !cmp.Equal(got, want) {
+		t.Errorf("blocked = %v, want %v", got, want)
+	}
+
+	if !strings.Contains(err.Error(), "aws_vpc not swept: blocked by aws_internet_gateway") {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	// All network interfaces are deleted before any subnet.
+	if i := strings.LastIndex(strings.Join(deleted, ","), "aws_network_interface"); i > strings.Index(strings.Join(deleted, ","), "aws_subnet") {
+		t.Errorf("subnets deleted before network interfaces: %v", deleted)
+	}
+
+	if got := maxActive; got > 2 {
+		t.Errorf("%d concurrent deletions, want at most 2", got)
+	}
+}
@@ -0,0 +1,128 @@
+package sweep
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	ActionDelete  = "delete"
+	ActionExclude = "exclude"
+)
+
+// ReportEntry describes what was, or in a dry run would have been, done with a swept resource.
+type ReportEntry struct {
+	Action       string            `json:"action"`
+	Region       string            `json:"region"`
+	ResourceType string            `json:"resource_type"`
+	ID           string            `json:"id"`
+	Reason       string            `json:"reason,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"` // Tags protecting an excluded resource.
+	CreationTime time.Time         `json:"-"`
+}
+
+var reportHeader = []string{"action", "region", "resource_type", "id", "reason", "tags"}
+
+func (e *ReportEntry) csvRecord() []string {
+	keys := sortedKeys(e.Tags)
+	tags := make([]string, len(keys))
+
+	for i, k := range keys {
+		tags[i] = fmt.Sprintf("%s=%s", k, e.Tags[k])
+	}
+
+	return []string{e.Action, e.Region, e.ResourceType, e.ID, e.Reason, strings.Join(tags, ";")}
+}
+
+// ReportWriter writes report entries as CSV or as JSON with one object per line.
+type ReportWriter struct {
+	mutex sync.Mutex
+	csv   *csv.Writer
+	json  *json.Encoder
+}
+
+// NewReportWriter returns a new ReportWriter. A CSV header is written immediately.
+func NewReportWriter(w io.Writer, csvFormat bool) (*ReportWriter, error) {
+	if !csvFormat {
+		return &ReportWriter{json: json.NewEncoder(w)}, nil
+	}
+
+	rw := &ReportWriter{csv: csv.NewWriter(w)}
+
+	if err := rw.writeCSV(reportHeader); err != nil {
+		return nil, err
+	}
+
+	return rw, nil
+}
+
+// Write writes a report entry.
+func (rw *ReportWriter) Write(entry *ReportEntry) error {
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+
+	if rw.csv != nil {
+		return rw.writeCSV(entry.csvRecord())
+	}
+
+	return rw.json.Encode(entry)
+}
+
+func (rw *ReportWriter) writeCSV(record []string) error {
+	if err := rw.csv.Write(record); err != nil {
+		return err
+	}
+
+	rw.csv.Flush()
+
+	return rw.csv.Error()
+}
+
+var (
+	reportWriter     *ReportWriter
+	reportWriterErr  error
+	reportWriterOnce sync.Once
+)
+
+// report logs a report entry and writes it to the report file, if configured.
+// Entries are written as they occur as sweepers exit the process when complete.
+func report(entry *ReportEntry) error {
+	switch {
+	case entry.Action == ActionExclude:
+		log.Printf("[INFO] Not sweeping %s (%s) in %s: %s", entry.ResourceType, entry.ID, entry.Region, entry.Reason)
+	case entry.Reason != "":
+		log.Printf("[INFO] Would sweep %s (%s) in %s: %s", entry.ResourceType, entry.ID, entry.Region, entry.Reason)
+	}
+
+	filename := os.Getenv(envvar.SweepReport)
+
+	if filename == "" {
+		return nil
+	}
+
+	reportWriterOnce.Do(func() {
+		f, err := os.Create(filename)
+
+		if err != nil {
+			reportWriterErr = fmt.Errorf("creating sweeper report: %w", err)
+			return
+		}
+
+		reportWriter, reportWriterErr = NewReportWriter(f, strings.HasSuffix(strings.ToLower(filename), ".csv"))
+	})
+
+	if reportWriterErr != nil {
+		return reportWriterErr
+	}
+
+	return reportWriter.Write(entry)
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -58,31 +59,43 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		}
 	}
 
+	sweepFilters, err := configuredFilters()
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &conns.Config{
-		MaxRetries:       5,
-		Region:           region,
-		SuppressDebugLog: true,
+		MaxRetries: 5,
+		// Sweepers that delete resources directly rather than through SweepOrchestrator or SweepPlanner
+		// would bypass a dry run and tag and age exclusions, so refuse their API calls.
+		RefuseMutatingAPICalls: sweepFilters.restrictive(),
+		Region:                 region,
+		SuppressDebugLog:       true,
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN:  role,
+			Duration: time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -149,7 +162,7 @@ func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, o
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			return deleteSweepable(ctx, sweepable, optFns...)
 		})
 	}
 
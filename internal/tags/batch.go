@@ -0,0 +1,203 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBatchWindow is the default time that tag updates are collected for before a batch is applied.
+	DefaultBatchWindow = 100 * time.Millisecond
+	// DefaultBatchSize is the default maximum number of resources in a batch.
+	// The Resource Groups Tagging API tags and untags at most 20 resources at once.
+	DefaultBatchSize = 20
+)
+
+// BulkTagger applies the same tag changes to multiple resources at once.
+// Implementations return an error for each resource that could not be tagged or untagged.
+type BulkTagger interface {
+	TagResources(ctx context.Context, identifiers []string, tags KeyValueTags) (map[string]error, error)
+	UntagResources(ctx context.Context, identifiers []string, keys []string) (map[string]error, error)
+}
+
+// Batcher coalesces resource tag updates and applies them with a BulkTagger.
+// Updates for resources in the same Region and service that remove and add or update the same tags are applied together.
+type Batcher struct {
+	window time.Duration
+	size   int
+
+	mu      sync.Mutex
+	batches map[string]*tagBatch
+}
+
+// NewBatcher returns a Batcher that collects tag updates for the specified window,
+// applying a batch early once it contains the specified number of resources.
+func NewBatcher(window time.Duration, size int) *Batcher {
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+
+	return &Batcher{
+		window:  window,
+		size:    size,
+		batches: make(map[string]*tagBatch),
+	}
+}
+
+// Update enqueues an update of the specified resource's tags and waits for the batch containing it to be applied.
+// oldTags and newTags are any of the types supported by New.
+// An error is returned if the bulk tagger fails to update the resource's tags, in which case the caller
+// should update the resource's tags using the service API.
+func (b *Batcher) Update(ctx context.Context, region, serviceName, identifier string, oldTags, newTags any, bulk BulkTagger) error {
+	o, n := New(ctx, oldTags), New(ctx, newTags)
+	removedTags := o.Removed(n).IgnoreSystem(serviceName)
+	updatedTags := o.Updated(n).IgnoreSystem(serviceName)
+
+	if len(removedTags) == 0 && len(updatedTags) == 0 {
+		return nil
+	}
+
+	batch := b.enqueue(ctx, batchKey(region, serviceName, removedTags, updatedTags), identifier, removedTags, updatedTags, bulk)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-batch.done:
+		return batch.errs[identifier]
+	}
+}
+
+// enqueue adds the resource to the open batch with the specified key, creating the batch if necessary.
+func (b *Batcher) enqueue(ctx context.Context, key, identifier string, removedTags, updatedTags KeyValueTags, bulk BulkTagger) *tagBatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch, ok := b.batches[key]
+
+	if !ok {
+		batch = &tagBatch{
+			bulk:        bulk,
+			removedTags: removedTags,
+			updatedTags: updatedTags,
+			done:        make(chan struct{}),
+		}
+		b.batches[key] = batch
+		// The batch is applied in the background, independently of the Context of any of its updates.
+		ctx := withoutCancel{ctx}
+		batch.timer = time.AfterFunc(b.window, func() {
+			b.flush(ctx, key, batch)
+		})
+	}
+
+	batch.identifiers = append(batch.identifiers, identifier)
+
+	if len(batch.identifiers) >= b.size && batch.timer.Stop() {
+		go b.flush(withoutCancel{ctx}, key, batch)
+	}
+
+	return batch
+}
+
+// flush closes the batch to further updates and applies it.
+func (b *Batcher) flush(ctx context.Context, key string, batch *tagBatch) {
+	b.mu.Lock()
+	if b.batches[key] == batch {
+		delete(b.batches, key)
+	}
+	identifiers := batch.identifiers
+	b.mu.Unlock()
+
+	batch.apply(ctx, identifiers)
+	close(batch.done)
+}
+
+// tagBatch is a set of resources with the same tag changes.
+type tagBatch struct {
+	bulk        BulkTagger
+	removedTags KeyValueTags
+	updatedTags KeyValueTags
+	identifiers []string
+	timer       *time.Timer
+	done        chan struct{}
+	// errs is written before done is closed.
+	errs map[string]error
+}
+
+func (batch *tagBatch) apply(ctx context.Context, identifiers []string) {
+	batch.errs = make(map[string]error)
+
+	setErrs := func(errs map[string]error, err error) {
+		for _, identifier := range identifiers {
+			if _, ok := batch.errs[identifier]; ok {
+				continue
+			}
+
+			if err != nil {
+				batch.errs[identifier] = err
+			} else if err, ok := errs[identifier]; ok && err != nil {
+				batch.errs[identifier] = err
+			}
+		}
+	}
+
+	if len(batch.removedTags) > 0 {
+		setErrs(batch.bulk.UntagResources(ctx, identifiers, batch.removedTags.Keys()))
+	}
+
+	if len(batch.updatedTags) > 0 {
+		// Resources that could not be untagged are updated using the service API.
+		var remaining []string
+		for _, identifier := range identifiers {
+			if _, ok := batch.errs[identifier]; !ok {
+				remaining = append(remaining, identifier)
+			}
+		}
+
+		if len(remaining) > 0 {
+			setErrs(batch.bulk.TagResources(ctx, remaining, batch.updatedTags))
+		}
+	}
+}
+
+// batchKey returns a key identifying updates that can be applied together.
+func batchKey(region, serviceName string, removedTags, updatedTags KeyValueTags) string {
+	removedKeys := removedTags.Keys()
+	sort.Strings(removedKeys)
+
+	v, _ := json.Marshal(struct {
+		Region      string
+		ServiceName string
+		Removed     []string
+		Updated     map[string]string
+	}{
+		Region:      region,
+		ServiceName: serviceName,
+		Removed:     removedKeys,
+		Updated:     updatedTags.Map(),
+	})
+
+	return string(v)
+}
+
+// withoutCancel is a Context that has the values of its parent but is never canceled.
+type withoutCancel struct {
+	context.Context
+}
+
+func (withoutCancel) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (withoutCancel) Done() <-chan struct{} {
+	return nil
+}
+
+func (withoutCancel) Err() error {
+	return nil
+}
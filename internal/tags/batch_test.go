@@ -0,0 +1,225 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testBulkTagger struct {
+	mu    sync.Mutex
+	calls []string
+	// failed are identifiers for which a per-resource error is returned.
+	failed map[string]struct{}
+	// err is returned for all calls.
+	err error
+}
+
+func (t *testBulkTagger) TagResources(_ context.Context, identifiers []string, tags KeyValueTags) (map[string]error, error) {
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	return t.call("tag", identifiers, keys)
+}
+
+func (t *testBulkTagger) UntagResources(_ context.Context, identifiers []string, keys []string) (map[string]error, error) {
+	sort.Strings(keys)
+
+	return t.call("untag", identifiers, keys)
+}
+
+func (t *testBulkTagger) call(action string, identifiers []string, keys []string) (map[string]error, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	identifiers = append([]string{}, identifiers...)
+	sort.Strings(identifiers)
+	t.calls = append(t.calls, action+" "+strings.Join(identifiers, ",")+" "+strings.Join(keys, ","))
+
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	errs := make(map[string]error)
+	for _, identifier := range identifiers {
+		if _, ok := t.failed[identifier]; ok {
+			errs[identifier] = errors.New("failed")
+		}
+	}
+
+	return errs, nil
+}
+
+func TestBatcherUpdate(t *testing.T) {
+	t.Parallel()
+
+	type update struct {
+		Region     string
+		Identifier string
+		OldTags    map[string]string
+		NewTags    map[string]string
+	}
+
+	testCases := []struct {
+		Name          string
+		Updates       []update
+		Failed        []string
+		Err           error
+		ExpectedCalls []string
+		ExpectedErrs  []string
+	}{
+		{
+			Name: "same changes",
+			Updates: []update{
+				{Region: "us-west-2", Identifier: "arn1", OldTags: map[string]string{"k1": "v1", "k2": "v2"}, NewTags: map[string]string{"k1": "v1-updated"}},
+				{Region: "us-west-2", Identifier: "arn2", OldTags: map[string]string{"k1": "v1", "k2": "v2"}, NewTags: map[string]string{"k1": "v1-updated"}},
+			},
+			ExpectedCalls: []string{
+				"untag arn1,arn2 k2",
+				"tag arn1,arn2 k1",
+			},
+		},
+		{
+			Name: "different changes",
+			Updates: []update{
+				{Region: "us-west-2", Identifier: "arn1", OldTags: map[string]string{}, NewTags: map[string]string{"k1": "v1"}},
+				{Region: "us-west-2", Identifier: "arn2", OldTags: map[string]string{}, NewTags: map[string]string{"k1": "v2"}},
+			},
+			ExpectedCalls: []string{
+				"tag arn1 k1",
+				"tag arn2 k1",
+			},
+		},
+		{
+			Name: "different Regions",
+			Updates: []update{
+				{Region: "us-west-2", Identifier: "arn1", OldTags: map[string]string{}, NewTags: map[string]string{"k1": "v1"}},
+				{Region: "us-east-1", Identifier: "arn2", OldTags: map[string]string{}, NewTags: map[string]string{"k1": "v1"}},
+			},
+			ExpectedCalls: []string{
+				"tag arn1 k1",
+				"tag arn2 k1",
+			},
+		},
+		{
+			Name: "no changes",
+			Updates: []update{
+				{Region: "us-west-2", Identifier: "arn1", OldTags: map[string]string{"k1": "v1"}, NewTags: map[string]string{"k1": "v1"}},
+			},
+		},
+		{
+			Name: "resource failed",
+			Updates: []update{
+				{Region: "us-west-2", Identifier: "arn1", OldTags: map[string]string{"k2": "v2"}, NewTags: map[string]string{"k1": "v1"}},
+				{Region: "us-west-2", Identifier: "arn2", OldTags: map[string]string{"k2": "v2"}, NewTags: map[string]string{"k1": "v1"}},
+			},
+			Failed: []string{"arn1"},
+			ExpectedCalls: []string{
+				"untag arn1,arn2 k2",
+				"tag arn2 k1",
+			},
+			ExpectedErrs: []string{"arn1"},
+		},
+		{
+			Name: "call failed",
+			Updates: []update{
+				{Region: "us-west-2", Identifier: "arn1", OldTags: map[string]string{}, NewTags: map[string]string{"k1": "v1"}},
+				{Region: "us-west-2", Identifier: "arn2", OldTags: map[string]string{}, NewTags: map[string]string{"k1": "v1"}},
+			},
+			Err: errors.New("AccessDenied"),
+			ExpectedCalls: []string{
+				"tag arn1,arn2 k1",
+			},
+			ExpectedErrs: []string{"arn1", "arn2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			bulk := &testBulkTagger{
+				failed: make(map[string]struct{}),
+				err:    testCase.Err,
+			}
+			for _, v := range testCase.Failed {
+				bulk.failed[v] = struct{}{}
+			}
+			// A long window so that all updates are in the same batch.
+			b := NewBatcher(time.Second, DefaultBatchSize)
+
+			var mu sync.Mutex
+			var errs []string
+			var wg sync.WaitGroup
+
+			for _, v := range testCase.Updates {
+				v := v
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					if err := b.Update(ctx, v.Region, "test", v.Identifier, v.OldTags, v.NewTags, bulk); err != nil {
+						mu.Lock()
+						errs = append(errs, v.Identifier)
+						mu.Unlock()
+					}
+				}()
+			}
+
+			wg.Wait()
+
+			calls := bulk.calls
+			sort.Strings(calls)
+			expectedCalls := append([]string{}, testCase.ExpectedCalls...)
+			sort.Strings(expectedCalls)
+
+			if got, want := strings.Join(calls, "; "), strings.Join(expectedCalls, "; "); got != want {
+				t.Errorf("calls = %q, want %q", got, want)
+			}
+
+			sort.Strings(errs)
+
+			if got, want := strings.Join(errs, ","), strings.Join(testCase.ExpectedErrs, ","); got != want {
+				t.Errorf("errors = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBatcherUpdateSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bulk := &testBulkTagger{}
+	// A batch is applied as soon as it is full.
+	b := NewBatcher(time.Hour, 2)
+
+	var wg sync.WaitGroup
+
+	for _, identifier := range []string{"arn1", "arn2"} {
+		identifier := identifier
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := b.Update(ctx, "us-west-2", "test", identifier, map[string]string{}, map[string]string{"k1": "v1"}, bulk); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := strings.Join(bulk.calls, "; "), "tag arn1,arn2 k1"; got != want {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
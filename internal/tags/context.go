@@ -14,6 +14,8 @@ type InContext struct {
 	TagsIn types.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
 	TagsOut types.Option[KeyValueTags]
+	// CreateTags identifies where TagsIn are set in the request that creates the resource, if any.
+	CreateTags *CreateTags
 }
 
 // NewContext returns a Context enhanced with tagging information.
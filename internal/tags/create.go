@@ -0,0 +1,138 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// CreateTags identifies the API request that creates a resource and the field of its input that the resource's tags are set in.
+type CreateTags struct {
+	Operation string // API operation, e.g. "CreateChannel"
+	Field     string // Field of the API operation's input, e.g. "Tags"
+}
+
+// SetCreateTags sets the tags in Context in the input of the specified API operation,
+// if the operation creates the resource in Context.
+// Tags already set in the input are not overwritten.
+func SetCreateTags(ctx context.Context, operation string, input any) error {
+	inContext, ok := FromContext(ctx)
+	if !ok || inContext.CreateTags == nil || inContext.CreateTags.Operation != operation {
+		return nil
+	}
+
+	tags := inContext.TagsIn.UnwrapOrDefault()
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if err := setTagsField(input, inContext.CreateTags.Field, tags); err != nil {
+		return fmt.Errorf("setting %s tags: %w", operation, err)
+	}
+
+	return nil
+}
+
+// setTagsField sets the specified field of an API operation's input to the tags.
+// The field is either a map of strings, or a slice of structures with Key and Value fields.
+// Strings may be pointers.
+func setTagsField(input any, name string, tags KeyValueTags) error {
+	v := reflect.ValueOf(input)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported input type: %T", input)
+	}
+
+	field := v.Elem().FieldByName(name)
+	if !field.IsValid() {
+		return fmt.Errorf("%T has no field %s", input, name)
+	}
+
+	if !field.IsZero() {
+		return nil
+	}
+
+	m := tags.Map()
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	switch t := field.Type(); t.Kind() {
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported %T field %s type: %s", input, name, t)
+		}
+
+		result := reflect.MakeMapWithSize(t, len(keys))
+
+		for _, k := range keys {
+			v, err := stringValue(t.Elem(), m[k])
+			if err != nil {
+				return fmt.Errorf("unsupported %T field %s type: %w", input, name, err)
+			}
+
+			result.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), v)
+		}
+
+		field.Set(result)
+	case reflect.Slice:
+		elem := t.Elem()
+		isPointer := elem.Kind() == reflect.Pointer
+		if isPointer {
+			elem = elem.Elem()
+		}
+
+		if elem.Kind() != reflect.Struct {
+			return fmt.Errorf("unsupported %T field %s type: %s", input, name, t)
+		}
+
+		result := reflect.MakeSlice(t, 0, len(keys))
+
+		for _, k := range keys {
+			tag := reflect.New(elem)
+
+			for fieldName, s := range map[string]string{"Key": k, "Value": m[k]} {
+				f := tag.Elem().FieldByName(fieldName)
+				if !f.IsValid() {
+					return fmt.Errorf("%s has no field %s", elem, fieldName)
+				}
+
+				v, err := stringValue(f.Type(), s)
+				if err != nil {
+					return fmt.Errorf("unsupported %s field %s type: %w", elem, fieldName, err)
+				}
+
+				f.Set(v)
+			}
+
+			if isPointer {
+				result = reflect.Append(result, tag)
+			} else {
+				result = reflect.Append(result, tag.Elem())
+			}
+		}
+
+		field.Set(result)
+	default:
+		return fmt.Errorf("unsupported %T field %s type: %s", input, name, t)
+	}
+
+	return nil
+}
+
+// stringValue returns the specified string as a value of the specified string or string pointer type.
+func stringValue(t reflect.Type, s string) (reflect.Value, error) {
+	switch {
+	case t.Kind() == reflect.String:
+		return reflect.ValueOf(s).Convert(t), nil
+	case t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.String:
+		v := reflect.New(t.Elem())
+		v.Elem().Set(reflect.ValueOf(s).Convert(t.Elem()))
+
+		return v, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("%s", t)
+	}
+}
@@ -0,0 +1,134 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type testTag struct {
+	Key   *string
+	Value *string
+}
+
+type testCreateInput struct {
+	Name        *string
+	MapTags     map[string]string
+	PtrMapTags  map[string]*string
+	SliceTags   []testTag
+	PtrSliceTag []*testTag
+	Unsupported int
+}
+
+func TestSetCreateTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{"k1": "v1", "k2": "v2"})
+	k1, v1, k2, v2 := "k1", "v1", "k2", "v2"
+
+	testCases := []struct {
+		Name        string
+		CreateTags  *CreateTags
+		Operation   string
+		Input       *testCreateInput
+		Expected    *testCreateInput
+		ExpectError bool
+	}{
+		{
+			Name:      "no create tags",
+			Operation: "CreateThing",
+			Input:     &testCreateInput{},
+			Expected:  &testCreateInput{},
+		},
+		{
+			Name:       "other operation",
+			CreateTags: &CreateTags{Operation: "CreateThing", Field: "MapTags"},
+			Operation:  "DescribeThing",
+			Input:      &testCreateInput{},
+			Expected:   &testCreateInput{},
+		},
+		{
+			Name:       "map",
+			CreateTags: &CreateTags{Operation: "CreateThing", Field: "MapTags"},
+			Operation:  "CreateThing",
+			Input:      &testCreateInput{},
+			Expected:   &testCreateInput{MapTags: map[string]string{"k1": "v1", "k2": "v2"}},
+		},
+		{
+			Name:       "pointer map",
+			CreateTags: &CreateTags{Operation: "CreateThing", Field: "PtrMapTags"},
+			Operation:  "CreateThing",
+			Input:      &testCreateInput{},
+			Expected:   &testCreateInput{PtrMapTags: map[string]*string{"k1": &v1, "k2": &v2}},
+		},
+		{
+			Name:       "slice",
+			CreateTags: &CreateTags{Operation: "CreateThing", Field: "SliceTags"},
+			Operation:  "CreateThing",
+			Input:      &testCreateInput{},
+			Expected:   &testCreateInput{SliceTags: []testTag{{Key: &k1, Value: &v1}, {Key: &k2, Value: &v2}}},
+		},
+		{
+			Name:       "pointer slice",
+			CreateTags: &CreateTags{Operation: "CreateThing", Field: "PtrSliceTag"},
+			Operation:  "CreateThing",
+			Input:      &testCreateInput{},
+			Expected:   &testCreateInput{PtrSliceTag: []*testTag{{Key: &k1, Value: &v1}, {Key: &k2, Value: &v2}}},
+		},
+		{
+			Name:       "already set",
+			CreateTags: &CreateTags{Operation: "CreateThing", Field: "MapTags"},
+			Operation:  "CreateThing",
+			Input:      &testCreateInput{MapTags: map[string]string{"k3": "v3"}},
+			Expected:   &testCreateInput{MapTags: map[string]string{"k3": "v3"}},
+		},
+		{
+			Name:        "no field",
+			CreateTags:  &CreateTags{Operation: "CreateThing", Field: "Tags"},
+			Operation:   "CreateThing",
+			Input:       &testCreateInput{},
+			ExpectError: true,
+		},
+		{
+			Name:        "unsupported field",
+			CreateTags:  &CreateTags{Operation: "CreateThing", Field: "Unsupported"},
+			Operation:   "CreateThing",
+			Input:       &testCreateInput{},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewContext(context.Background(), nil, nil)
+			inContext, _ := FromContext(ctx)
+			inContext.TagsIn = types.Some(tags)
+			inContext.CreateTags = testCase.CreateTags
+
+			err := SetCreateTags(ctx, testCase.Operation, testCase.Input)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Input, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -25,6 +26,49 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludedServices are the service package names of resources that have no default tags.
+	ExcludedServices []string
+	// ExcludedResourceTypes are the Terraform type names of resources that have no default tags.
+	ExcludedResourceTypes []string
+	// ScopedTags are tags to default across a subset of resources.
+	ScopedTags []DefaultScopedTags
+}
+
+// DefaultScopedTags contains tags to default across resources of the specified services and Terraform types.
+// An empty list of services or types matches all services or types.
+type DefaultScopedTags struct {
+	Services      []string
+	ResourceTypes []string
+	Tags          KeyValueTags
+}
+
+func (st DefaultScopedTags) matches(servicePackageName, typeName string) bool {
+	return (len(st.Services) == 0 || slices.Contains(st.Services, servicePackageName)) && (len(st.ResourceTypes) == 0 || slices.Contains(st.ResourceTypes, typeName))
+}
+
+// ForResource returns the DefaultConfig for resources of the specified service and Terraform type,
+// with any scoped tags merged and exclusions applied.
+// MergeTags, RemoveDefaultConfig and TagsEqual should be passed the DefaultConfig for the resource.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || (len(dc.ExcludedServices) == 0 && len(dc.ExcludedResourceTypes) == 0 && len(dc.ScopedTags) == 0) {
+		return dc
+	}
+
+	if slices.Contains(dc.ExcludedServices, servicePackageName) || slices.Contains(dc.ExcludedResourceTypes, typeName) {
+		return nil
+	}
+
+	tags := dc.Tags
+
+	for _, v := range dc.ScopedTags {
+		if v.matches(servicePackageName, typeName) {
+			tags = tags.Merge(v.Tags)
+		}
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -54,6 +54,132 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+		}),
+		ExcludedServices:      []string{"s3"},
+		ExcludedResourceTypes: []string{"aws_ec2_tag"},
+		ScopedTags: []DefaultScopedTags{
+			{
+				ResourceTypes: []string{"aws_db_instance", "aws_ebs_volume"},
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+				}),
+			},
+			{
+				Services: []string{"rds"},
+				Tags: New(ctx, map[string]string{
+					"key1": "value1-rds",
+				}),
+			},
+			{
+				Services:      []string{"rds"},
+				ResourceTypes: []string{"aws_db_subnet_group"},
+				Tags: New(ctx, map[string]string{
+					"key3": "value3",
+				}),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			defaultConfig:      nil,
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want:               map[string]string{},
+		},
+		{
+			name: "unscoped config",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:               "no matching scope",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:               "resource type scope",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ebs_volume",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name:               "multiple scopes",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+			want: map[string]string{
+				"key1": "value1-rds",
+				"key2": "value2",
+			},
+		},
+		{
+			name:               "service and resource type scope",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "rds",
+			typeName:           "aws_db_subnet_group",
+			want: map[string]string{
+				"key1": "value1-rds",
+				"key3": "value3",
+			},
+		},
+		{
+			name:               "excluded service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               map[string]string{},
+		},
+		{
+			name:               "excluded resource type",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ec2_tag",
+			want:               map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,110 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	PolicyEnforcementError = "error"
+	PolicyEnforcementWarn  = "warn"
+)
+
+func PolicyEnforcementValues() []string {
+	return []string{
+		PolicyEnforcementError,
+		PolicyEnforcementWarn,
+	}
+}
+
+// PolicyConfig contains the tag policy that resource tags are validated against.
+type PolicyConfig struct {
+	// Enforcement is whether policy violations are reported as errors or warnings.
+	Enforcement string
+	// RequiredKeys are the tag keys that all resources must have.
+	RequiredKeys []string
+	// AllowedValues are the values allowed for a tag key.
+	AllowedValues map[string][]string
+	// MaxTags is the maximum number of tags per resource, keyed by service package name.
+	MaxTags map[string]int
+}
+
+// Warn returns whether policy violations are reported as warnings.
+func (pc *PolicyConfig) Warn() bool {
+	if pc == nil {
+		return false
+	}
+
+	return pc.Enforcement == PolicyEnforcementWarn
+}
+
+// Validate returns the violations of the policy by the specified service's resource tags.
+// Tags are expected to include any default tags and exclude any system tags.
+func (pc *PolicyConfig) Validate(serviceName string, tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	// Keys referenced by the policy, by lower case key.
+	policyKeys := make(map[string]string)
+	for _, key := range pc.RequiredKeys {
+		policyKeys[strings.ToLower(key)] = key
+	}
+	for key := range pc.AllowedValues {
+		policyKeys[strings.ToLower(key)] = key
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if policyKey, ok := policyKeys[strings.ToLower(key)]; ok && policyKey != key {
+			violations = append(violations, fmt.Sprintf("tag key %q does not match the case of tag policy key %q", key, policyKey))
+		}
+	}
+
+	requiredKeys := append([]string{}, pc.RequiredKeys...)
+	sort.Strings(requiredKeys)
+
+	for _, key := range requiredKeys {
+		if !tags.KeyExists(key) {
+			violations = append(violations, fmt.Sprintf("required tag key %q is missing", key))
+		}
+	}
+
+	allowedKeys := make([]string, 0, len(pc.AllowedValues))
+	for key := range pc.AllowedValues {
+		allowedKeys = append(allowedKeys, key)
+	}
+	sort.Strings(allowedKeys)
+
+	for _, key := range allowedKeys {
+		v := tags.KeyValue(key)
+
+		if v == nil {
+			continue
+		}
+
+		values := pc.AllowedValues[key]
+		allowed := false
+		for _, value := range values {
+			if *v == value {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values: %s", key, *v, strings.Join(values, ", ")))
+		}
+	}
+
+	if v, ok := pc.MaxTags[serviceName]; ok && len(tags) > v {
+		violations = append(violations, fmt.Sprintf("%d tags exceed the maximum of %d tags per resource", len(tags), v))
+	}
+
+	return violations
+}
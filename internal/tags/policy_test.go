@@ -0,0 +1,143 @@
+package tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	policyConfig := &PolicyConfig{
+		RequiredKeys: []string{"CostCenter", "Owner"},
+		AllowedValues: map[string][]string{
+			"DataClass": {"public", "internal"},
+		},
+		MaxTags: map[string]int{
+			"s3": 3,
+		},
+	}
+
+	testCases := []struct {
+		Name               string
+		PolicyConfig       *PolicyConfig
+		ServiceName        string
+		Tags               map[string]string
+		ExpectedViolations []string
+	}{
+		{
+			Name:         "no policy",
+			PolicyConfig: nil,
+			ServiceName:  "ec2",
+			Tags:         map[string]string{},
+		},
+		{
+			Name:         "compliant",
+			PolicyConfig: policyConfig,
+			ServiceName:  "ec2",
+			Tags:         map[string]string{"CostCenter": "1234", "Owner": "team", "DataClass": "public", "Name": "test"},
+		},
+		{
+			Name:         "missing key",
+			PolicyConfig: policyConfig,
+			ServiceName:  "ec2",
+			Tags:         map[string]string{"CostCenter": "1234"},
+			ExpectedViolations: []string{
+				`required tag key "Owner" is missing`,
+			},
+		},
+		{
+			Name:         "disallowed value",
+			PolicyConfig: policyConfig,
+			ServiceName:  "ec2",
+			Tags:         map[string]string{"CostCenter": "1234", "Owner": "team", "DataClass": "secret"},
+			ExpectedViolations: []string{
+				`tag "DataClass" value "secret" is not one of the allowed values: public, internal`,
+			},
+		},
+		{
+			Name:         "key casing",
+			PolicyConfig: policyConfig,
+			ServiceName:  "ec2",
+			Tags:         map[string]string{"costcenter": "1234", "Owner": "team", "dataclass": "secret"},
+			ExpectedViolations: []string{
+				`tag key "costcenter" does not match the case of tag policy key "CostCenter"`,
+				`tag key "dataclass" does not match the case of tag policy key "DataClass"`,
+				`required tag key "CostCenter" is missing`,
+			},
+		},
+		{
+			Name:         "service maximum tags",
+			PolicyConfig: policyConfig,
+			ServiceName:  "s3",
+			Tags:         map[string]string{"CostCenter": "1234", "Owner": "team", "DataClass": "public", "Name": "test"},
+			ExpectedViolations: []string{
+				"4 tags exceed the maximum of 3 tags per resource",
+			},
+		},
+		{
+			Name:         "other service maximum tags",
+			PolicyConfig: &PolicyConfig{MaxTags: map[string]int{"s3": 1}},
+			ServiceName:  "ec2",
+			Tags:         map[string]string{"k1": "v1", "k2": "v2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			violations := testCase.PolicyConfig.Validate(testCase.ServiceName, New(context.Background(), testCase.Tags))
+
+			if got, want := strings.Join(violations, "\n"), strings.Join(testCase.ExpectedViolations, "\n"); got != want {
+				t.Errorf("violations = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigWarn(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		PolicyConfig *PolicyConfig
+		Expected     bool
+	}{
+		{
+			Name:         "nil",
+			PolicyConfig: nil,
+			Expected:     false,
+		},
+		{
+			Name:         "default",
+			PolicyConfig: &PolicyConfig{},
+			Expected:     false,
+		},
+		{
+			Name:         "error",
+			PolicyConfig: &PolicyConfig{Enforcement: PolicyEnforcementError},
+			Expected:     false,
+		},
+		{
+			Name:         "warn",
+			PolicyConfig: &PolicyConfig{Enforcement: PolicyEnforcementWarn},
+			Expected:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.PolicyConfig.Warn(), testCase.Expected; got != want {
+				t.Errorf("Warn = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -53,8 +53,10 @@ func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}
 }
 
 // RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error code.
+// These errors are usually caused by eventual consistency, so the timeout is extended to any configured IAM propagation timeout,
+// whether or not the error is caused by IAM.
 func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+	return RetryWhen(ctx, RetryPolicyFromContext(ctx).iamPropagationTimeout(timeout), f, func(err error) (bool, error) {
 		if tfawserr.ErrCodeEquals(err, codes...) {
 			return true, err
 		}
@@ -64,8 +66,9 @@ func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f fun
 }
 
 // RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
+// The timeout is extended to any configured IAM propagation timeout, whether or not the error is caused by IAM.
 func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+	return RetryWhen(ctx, RetryPolicyFromContext(ctx).iamPropagationTimeout(timeout), f, func(err error) (bool, error) {
 		if tfawserr.ErrMessageContains(err, code, message) {
 			return true, err
 		}
@@ -74,8 +77,10 @@ func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration,
 	})
 }
 
+// RetryWhenIsAErrorMessageContains retries the specified function when it returns an error of type T containing the specified message.
+// The timeout is extended to any configured IAM propagation timeout, whether or not the error is caused by IAM.
 func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (interface{}, error), needle string) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+	return RetryWhen(ctx, RetryPolicyFromContext(ctx).iamPropagationTimeout(timeout), f, func(err error) (bool, error) {
 		if errs.IsAErrorMessageContains[T](err, needle) {
 			return true, err
 		}
@@ -129,6 +134,10 @@ type Options struct {
 	PollInterval              time.Duration // Override MinPollInterval/backoff and only poll this often
 	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh)
 	ContinuousTargetOccurence int           // Number of times the Target state has to occur continuously
+	MaxAttempts               int           // Maximum number of attempts before a retryable error is returned
+	BackoffBase               time.Duration // Override MinPollInterval/backoff and wait this long before the second attempt, doubling each attempt
+	BackoffCap                time.Duration // Longest wait when BackoffBase is set
+	RetryableErrorCodes       []string      // Additional AWS error codes that are retried
 }
 
 func (o Options) Apply(c *retry.StateChangeConf) {
@@ -192,9 +201,30 @@ func WithContinuousTargetOccurence(continuousTargetOccurence int) OptionsFunc {
 	}
 }
 
+func WithMaxAttempts(maxAttempts int) OptionsFunc {
+	return func(o *Options) {
+		o.MaxAttempts = maxAttempts
+	}
+}
+
+// WithBackoff sets the wait before the second attempt, doubling before each later attempt up to maxWait
+func WithBackoff(base, maxWait time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.BackoffBase = base
+		o.BackoffCap = maxWait
+	}
+}
+
+func WithRetryableErrorCodes(codes ...string) OptionsFunc {
+	return func(o *Options) {
+		o.RetryableErrorCodes = append(o.RetryableErrorCodes, codes...)
+	}
+}
+
 // Retry allows configuration of StateChangeConf's various time arguments.
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
 // the default durations cause problems.
+// Any retry policy in Context takes precedence over the passed options.
 func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns ...OptionsFunc) error {
 	// These are used to pull the error out of the function; need a mutex to
 	// avoid a data race.
@@ -205,31 +235,45 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 	for _, fn := range optFns {
 		fn(&options)
 	}
+	RetryPolicyFromContext(ctx).apply(&options)
+
+	var attempts int
 
 	c := &retry.StateChangeConf{
 		Pending:    []string{"retryableerror"},
 		Target:     []string{"success"},
 		Timeout:    timeout,
 		MinTimeout: 500 * time.Millisecond,
-		Refresh: func() (interface{}, string, error) {
-			rerr := f()
+	}
 
-			resultErrMu.Lock()
-			defer resultErrMu.Unlock()
+	c.Refresh = func() (interface{}, string, error) {
+		rerr := f()
+		attempts++
 
-			if rerr == nil {
-				resultErr = nil
-				return 42, "success", nil
-			}
+		resultErrMu.Lock()
+		defer resultErrMu.Unlock()
 
-			resultErr = rerr.Err
+		if rerr == nil {
+			resultErr = nil
+			return 42, "success", nil
+		}
+
+		resultErr = rerr.Err
+
+		if !rerr.Retryable && len(options.RetryableErrorCodes) > 0 && tfawserr.ErrCodeEquals(rerr.Err, options.RetryableErrorCodes...) {
+			rerr.Retryable = true
+		}
 
-			if rerr.Retryable {
-				return 42, "retryableerror", nil
+		if rerr.Retryable && (options.MaxAttempts == 0 || attempts < options.MaxAttempts) {
+			if options.BackoffBase > 0 {
+				// The next poll happens after PollInterval, which is read after each refresh.
+				c.PollInterval = backoff(attempts+1, options.BackoffBase, options.BackoffCap)
 			}
 
-			return nil, "quit", rerr.Err
-		},
+			return 42, "retryableerror", nil
+		}
+
+		return nil, "quit", rerr.Err
 	}
 
 	options.Apply(c)
@@ -0,0 +1,131 @@
+package tfresource
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// defaultBackoffCap is the longest wait between attempts when a backoff base but no cap is configured.
+	// It matches the longest wait used by retry.StateChangeConf.
+	defaultBackoffCap = 10 * time.Second
+)
+
+// RetryPolicy is a provider-configured override of the retry behavior of the functions in this package.
+// Zero values leave the corresponding behavior unchanged.
+type RetryPolicy struct {
+	MaxAttempts           int           // Maximum number of attempts before a retryable error is returned
+	BackoffBase           time.Duration // Wait before the second attempt, doubled before each later attempt
+	BackoffCap            time.Duration // Longest wait between attempts
+	RetryableErrorCodes   []string      // Additional AWS error codes that are retried
+	IAMPropagationTimeout time.Duration // Minimum time to retry errors caused by eventual consistency, see iamPropagationTimeout
+}
+
+// RetryConfig contains the provider-configured retry policies.
+type RetryConfig struct {
+	Global   *RetryPolicy            // Applies to all services
+	Services map[string]*RetryPolicy // Keyed by service package name
+}
+
+// ForService returns the RetryPolicy for the specified service, with any service policy merged over the global policy.
+func (rc *RetryConfig) ForService(servicePackageName string) *RetryPolicy {
+	if rc == nil {
+		return nil
+	}
+
+	service, ok := rc.Services[servicePackageName]
+
+	if !ok {
+		return rc.Global
+	}
+
+	if rc.Global == nil {
+		return service
+	}
+
+	policy := *rc.Global
+
+	if v := service.MaxAttempts; v > 0 {
+		policy.MaxAttempts = v
+	}
+	if v := service.BackoffBase; v > 0 {
+		policy.BackoffBase = v
+	}
+	if v := service.BackoffCap; v > 0 {
+		policy.BackoffCap = v
+	}
+	if v := service.RetryableErrorCodes; len(v) > 0 {
+		policy.RetryableErrorCodes = append(append([]string(nil), policy.RetryableErrorCodes...), v...)
+	}
+	if v := service.IAMPropagationTimeout; v > 0 {
+		policy.IAMPropagationTimeout = v
+	}
+
+	return &policy
+}
+
+// apply overrides Options with any values set in the policy.
+func (p *RetryPolicy) apply(o *Options) {
+	if p == nil {
+		return
+	}
+
+	if p.MaxAttempts > 0 {
+		o.MaxAttempts = p.MaxAttempts
+	}
+
+	if p.BackoffBase > 0 {
+		o.BackoffBase = p.BackoffBase
+		o.BackoffCap = p.BackoffCap
+	}
+
+	if len(p.RetryableErrorCodes) > 0 {
+		o.RetryableErrorCodes = append(o.RetryableErrorCodes, p.RetryableErrorCodes...)
+	}
+}
+
+// iamPropagationTimeout returns the larger of the specified timeout and any policy IAM propagation timeout.
+// It applies to every retry by AWS error code or message, i.e. RetryWhenAWSErrCodeEquals, RetryWhenAWSErrMessageContains
+// and RetryWhenIsAErrorMessageContains, as the errors caused by IAM eventual consistency can't be told apart
+// from other eventual consistency errors that those functions retry.
+func (p *RetryPolicy) iamPropagationTimeout(timeout time.Duration) time.Duration {
+	if p == nil || p.IAMPropagationTimeout <= timeout {
+		return timeout
+	}
+
+	return p.IAMPropagationTimeout
+}
+
+type retryPolicyKeyType int
+
+var retryPolicyKey retryPolicyKeyType
+
+// NewRetryPolicyContext returns a Context enhanced with the specified retry policy.
+func NewRetryPolicyContext(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey, policy)
+}
+
+// RetryPolicyFromContext returns the retry policy kept in Context, if any.
+func RetryPolicyFromContext(ctx context.Context) *RetryPolicy {
+	v, _ := ctx.Value(retryPolicyKey).(*RetryPolicy)
+
+	return v
+}
+
+// backoff returns the wait before the specified attempt (1-based) following a retryable error.
+func backoff(attempt int, base, maxWait time.Duration) time.Duration {
+	if maxWait <= 0 {
+		maxWait = defaultBackoffCap
+	}
+
+	wait := base
+	for i := 2; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+
+	if wait > maxWait {
+		wait = maxWait
+	}
+
+	return wait
+}
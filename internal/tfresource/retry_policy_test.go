@@ -0,0 +1,152 @@
+package tfresource_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestRetryConfigForService(t *testing.T) {
+	t.Parallel()
+
+	global := &tfresource.RetryPolicy{
+		MaxAttempts:         5,
+		BackoffBase:         1 * time.Second,
+		RetryableErrorCodes: []string{"Throttling"},
+	}
+
+	testCases := map[string]struct {
+		config   *tfresource.RetryConfig
+		service  string
+		expected *tfresource.RetryPolicy
+	}{
+		"nil config": {
+			service: "iam",
+		},
+		"global only": {
+			config:   &tfresource.RetryConfig{Global: global},
+			service:  "iam",
+			expected: global,
+		},
+		"service only": {
+			config: &tfresource.RetryConfig{
+				Services: map[string]*tfresource.RetryPolicy{
+					"iam": {IAMPropagationTimeout: 5 * time.Minute},
+				},
+			},
+			service:  "iam",
+			expected: &tfresource.RetryPolicy{IAMPropagationTimeout: 5 * time.Minute},
+		},
+		"other service": {
+			config: &tfresource.RetryConfig{
+				Global: global,
+				Services: map[string]*tfresource.RetryPolicy{
+					"iam": {IAMPropagationTimeout: 5 * time.Minute},
+				},
+			},
+			service:  "ec2",
+			expected: global,
+		},
+		"merged": {
+			config: &tfresource.RetryConfig{
+				Global: global,
+				Services: map[string]*tfresource.RetryPolicy{
+					"iam": {
+						MaxAttempts:           10,
+						RetryableErrorCodes:   []string{"NoSuchEntity"},
+						IAMPropagationTimeout: 5 * time.Minute,
+					},
+				},
+			},
+			service: "iam",
+			expected: &tfresource.RetryPolicy{
+				MaxAttempts:           10,
+				BackoffBase:           1 * time.Second,
+				RetryableErrorCodes:   []string{"Throttling", "NoSuchEntity"},
+				IAMPropagationTimeout: 5 * time.Minute,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.ForService(testCase.service)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+
+	if got, want := global.RetryableErrorCodes, []string{"Throttling"}; !cmp.Equal(got, want) {
+		t.Errorf("global policy modified: %v", got)
+	}
+}
+
+func TestRetryWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy           *tfresource.RetryPolicy
+		err              error
+		retryable        bool
+		expectedAttempts int
+	}{
+		"no policy non-retryable": {
+			err:              awserr.New("TestCode", "TestMessage", nil),
+			expectedAttempts: 1,
+		},
+		"max attempts": {
+			policy:           &tfresource.RetryPolicy{MaxAttempts: 3, BackoffBase: 1 * time.Millisecond},
+			err:              awserr.New("TestCode", "TestMessage", nil),
+			retryable:        true,
+			expectedAttempts: 3,
+		},
+		"extra retryable error code": {
+			policy:           &tfresource.RetryPolicy{MaxAttempts: 2, BackoffBase: 1 * time.Millisecond, RetryableErrorCodes: []string{"TestCode"}},
+			err:              awserr.New("TestCode", "TestMessage", nil),
+			expectedAttempts: 2,
+		},
+		"other error code": {
+			policy:           &tfresource.RetryPolicy{MaxAttempts: 2, RetryableErrorCodes: []string{"OtherCode"}},
+			err:              awserr.New("TestCode", "TestMessage", nil),
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := tfresource.NewRetryPolicyContext(context.Background(), testCase.policy)
+
+			var attempts int
+			err := tfresource.Retry(ctx, 10*time.Second, func() *retry.RetryError {
+				attempts++
+
+				if testCase.retryable {
+					return retry.RetryableError(testCase.err)
+				}
+
+				return retry.NonRetryableError(testCase.err)
+			})
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := attempts, testCase.expectedAttempts; got != want {
+				t.Errorf("got %d attempts, want %d", got, want)
+			}
+		})
+	}
+}
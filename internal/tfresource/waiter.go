@@ -0,0 +1,96 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// WaiterStates are the pending and target statuses of a resource status change.
+type WaiterStates struct {
+	Pending []string
+	Target  []string
+}
+
+// Waiter waits for a resource's status to change.
+// Waiters are usually generated by internal/generate/waiters.
+type Waiter[T any] struct {
+	// Find returns the resource's current state, or a NotFound error if the resource does not exist.
+	Find func(context.Context) (*T, error)
+	// Status returns the resource's status.
+	Status func(*T) string
+	// StatusReason optionally returns the reason for the resource's status.
+	// A non-empty reason is set as the last error of a failed wait.
+	StatusReason func(*T) string
+
+	Created WaiterStates
+	Updated WaiterStates
+	// Deleted waits until the resource is not found. Target is ignored.
+	Deleted WaiterStates
+}
+
+// Refresh returns a function that refreshes the resource's status.
+// A nil result is returned if the resource is not found.
+func (w *Waiter[T]) Refresh(ctx context.Context) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := w.Find(ctx)
+
+		if NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, w.Status(output), nil
+	}
+}
+
+// WaitCreated waits for the resource's status to change from a Created pending status to a Created target status.
+func (w *Waiter[T]) WaitCreated(ctx context.Context, timeout time.Duration, optFns ...OptionsFunc) (*T, error) {
+	return w.WaitFor(ctx, w.Created, timeout, optFns...)
+}
+
+// WaitUpdated waits for the resource's status to change from an Updated pending status to an Updated target status.
+func (w *Waiter[T]) WaitUpdated(ctx context.Context, timeout time.Duration, optFns ...OptionsFunc) (*T, error) {
+	return w.WaitFor(ctx, w.Updated, timeout, optFns...)
+}
+
+// WaitDeleted waits for the resource to no longer be found while its status is a Deleted pending status.
+func (w *Waiter[T]) WaitDeleted(ctx context.Context, timeout time.Duration, optFns ...OptionsFunc) (*T, error) {
+	return w.WaitFor(ctx, WaiterStates{Pending: w.Deleted.Pending, Target: []string{}}, timeout, optFns...)
+}
+
+// WaitFor waits for the resource's status to change from a pending status to a target status.
+// If the target statuses are empty, WaitFor waits for the resource to no longer be found.
+func (w *Waiter[T]) WaitFor(ctx context.Context, states WaiterStates, timeout time.Duration, optFns ...OptionsFunc) (*T, error) {
+	options := Options{}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: states.Pending,
+		Target:  states.Target,
+		Refresh: w.Refresh(ctx),
+		Timeout: timeout,
+	}
+	options.Apply(stateConf)
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*T); ok {
+		if w.StatusReason != nil {
+			if reason := w.StatusReason(output); reason != "" {
+				SetLastError(err, errors.New(reason))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,136 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testWaiterResource struct {
+	Status       string
+	StatusReason string
+}
+
+// testWaiter returns a Waiter whose finder returns the specified statuses in turn.
+// An empty status means that the resource is not found.
+func testWaiter(statuses ...string) *tfresource.Waiter[testWaiterResource] {
+	i := 0
+
+	return &tfresource.Waiter[testWaiterResource]{
+		Find: func(context.Context) (*testWaiterResource, error) {
+			status := statuses[i]
+			if i < len(statuses)-1 {
+				i++
+			}
+
+			switch status {
+			case "":
+				return nil, &retry.NotFoundError{}
+			case "ERROR":
+				return nil, errors.New("finder error")
+			}
+
+			return &testWaiterResource{Status: status, StatusReason: "reason for " + status}, nil
+		},
+		Status:       func(v *testWaiterResource) string { return v.Status },
+		StatusReason: func(v *testWaiterResource) string { return v.StatusReason },
+		Created:      tfresource.WaiterStates{Pending: []string{"CREATING"}, Target: []string{"ACTIVE"}},
+		Updated:      tfresource.WaiterStates{Pending: []string{"UPDATING"}, Target: []string{"ACTIVE"}},
+		Deleted:      tfresource.WaiterStates{Pending: []string{"ACTIVE", "DELETING"}},
+	}
+}
+
+func TestWaiter(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Waiter         *tfresource.Waiter[testWaiterResource]
+		Wait           func(*tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error)
+		ExpectedStatus string
+		ExpectedError  string
+	}{
+		{
+			Name:   "created",
+			Waiter: testWaiter("CREATING", "CREATING", "ACTIVE"),
+			Wait: func(w *tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error) {
+				return w.WaitCreated(ctx, 5*time.Second, tfresource.WithPollInterval(time.Millisecond))
+			},
+			ExpectedStatus: "ACTIVE",
+		},
+		{
+			Name:   "create failed",
+			Waiter: testWaiter("CREATING", "FAILED"),
+			Wait: func(w *tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error) {
+				return w.WaitCreated(ctx, 5*time.Second, tfresource.WithPollInterval(time.Millisecond))
+			},
+			ExpectedStatus: "FAILED",
+			ExpectedError:  "reason for FAILED",
+		},
+		{
+			Name:   "updated",
+			Waiter: testWaiter("UPDATING", "ACTIVE"),
+			Wait: func(w *tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error) {
+				return w.WaitUpdated(ctx, 5*time.Second, tfresource.WithPollInterval(time.Millisecond))
+			},
+			ExpectedStatus: "ACTIVE",
+		},
+		{
+			Name:   "update timed out",
+			Waiter: testWaiter("UPDATING"),
+			Wait: func(w *tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error) {
+				return w.WaitUpdated(ctx, 50*time.Millisecond, tfresource.WithPollInterval(time.Millisecond))
+			},
+			ExpectedError: "timeout while waiting for state",
+		},
+		{
+			Name:   "deleted",
+			Waiter: testWaiter("ACTIVE", "DELETING", ""),
+			Wait: func(w *tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error) {
+				return w.WaitDeleted(ctx, 5*time.Second, tfresource.WithPollInterval(time.Millisecond))
+			},
+		},
+		{
+			Name:   "finder error",
+			Waiter: testWaiter("DELETING", "ERROR"),
+			Wait: func(w *tfresource.Waiter[testWaiterResource]) (*testWaiterResource, error) {
+				return w.WaitDeleted(ctx, 5*time.Second, tfresource.WithPollInterval(time.Millisecond))
+			},
+			ExpectedError: "finder error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := testCase.Wait(testCase.Waiter)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Errorf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			var status string
+			if output != nil {
+				status = output.Status
+			}
+
+			if got, want := status, testCase.ExpectedStatus; got != want {
+				t.Errorf("status = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -12,6 +12,15 @@ import (
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	CreateOnly          bool   // Tags can only be set when the resource is created. Implies ForceNewOnTagChange.
+	CreateOperation     string // The API operation that creates the resource, e.g. "CreateChannel"
+	CreateTagsField     string // The field of the API operation's input that tags are set in, e.g. "Tags"
+	ForceNewOnTagChange bool   // Changes to tags replace the resource.
+}
+
+// ForceNew returns whether changes to tags replace the resource.
+func (t *ServicePackageResourceTags) ForceNew() bool {
+	return t.CreateOnly || t.ForceNewOnTagChange
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
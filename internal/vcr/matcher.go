@@ -0,0 +1,136 @@
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// idempotencyTokenFields are request fields that the AWS SDKs populate with random values.
+// They are ignored when matching requests to recorded interactions so that replay is deterministic.
+var idempotencyTokenFields = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// Matcher returns a function that defines how requests are matched to recorded interactions.
+// The method, URL and body of the request must match. Request bodies are compared structurally
+// for the AWS JSON, query and REST-XML protocols, ignoring idempotency tokens and scrubbed values.
+func Matcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		// Compare method and URL, ignoring any request signature.
+		if r.Method != i.Method || scrubURL(r.URL.String()) != i.URL {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType := r.Header.Get("Content-Type"); contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			removeIdempotencyTokensJSON(requestJson)
+			removeIdempotencyTokensJSON(cassetteJson)
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/x-www-form-urlencoded", "application/x-www-form-urlencoded; charset=utf-8":
+			requestForm, err := url.ParseQuery(body)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteForm, err := url.ParseQuery(i.Body)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			for _, v := range append(idempotencyTokenFields, sensitiveParameters...) {
+				requestForm.Del(v)
+				cassetteForm.Del(v)
+			}
+
+			return reflect.DeepEqual(requestForm, cassetteForm)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
+
+func removeIdempotencyTokensJSON(v interface{}) {
+	if m, ok := v.(map[string]interface{}); ok {
+		for _, k := range idempotencyTokenFields {
+			delete(m, k)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package vcr
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const redacted = "REDACTED"
+
+var (
+	// sensitiveHeaders are HTTP headers that carry credentials or request signatures.
+	sensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// sensitiveParameters are query string or form parameters that carry credentials or request signatures.
+	sensitiveParameters = []string{
+		"SAMLAssertion",
+		"WebIdentityToken",
+		"X-Amz-Credential",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// sensitiveFields are response fields that carry credentials, e.g. from STS or IAM Identity Center.
+	sensitiveFields = []string{
+		"SecretAccessKey",
+		"SessionToken",
+		"secretAccessKey",
+		"sessionToken",
+	}
+
+	sensitiveFieldsJSONRegexp = regexp.MustCompile(fmt.Sprintf(`("(?:%s)"\s*:\s*)"[^"]*"`, strings.Join(sensitiveFields, "|")))
+	sensitiveFieldsXMLRegexps = make(map[string]*regexp.Regexp, len(sensitiveFields))
+)
+
+func init() {
+	for _, v := range sensitiveFields {
+		sensitiveFieldsXMLRegexps[v] = regexp.MustCompile(fmt.Sprintf(`(<%[1]s>)[^<]*(</%[1]s>)`, v))
+	}
+}
+
+// Scrub removes credentials and request signatures from a recorded interaction.
+func Scrub(i *cassette.Interaction) error {
+	for _, v := range sensitiveHeaders {
+		delete(i.Request.Headers, v)
+	}
+
+	i.Request.URL = scrubURL(i.Request.URL)
+
+	if form, ok := scrubValues(i.Request.Form); ok {
+		i.Request.Form = form
+	}
+
+	if form, err := url.ParseQuery(i.Request.Body); err == nil {
+		if form, ok := scrubValues(form); ok {
+			i.Request.Body = form.Encode()
+		}
+	}
+
+	i.Response.Body = scrubBody(i.Response.Body)
+
+	return nil
+}
+
+func scrubURL(v string) string {
+	u, err := url.Parse(v)
+
+	if err != nil {
+		return v
+	}
+
+	query, ok := scrubValues(u.Query())
+
+	if !ok {
+		return v
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// scrubValues redacts any sensitive parameters, returning whether any were found.
+func scrubValues(values url.Values) (url.Values, bool) {
+	var found bool
+
+	for _, v := range sensitiveParameters {
+		if values.Has(v) {
+			values.Set(v, redacted)
+			found = true
+		}
+	}
+
+	return values, found
+}
+
+func scrubBody(body string) string {
+	body = sensitiveFieldsJSONRegexp.ReplaceAllString(body, fmt.Sprintf(`${1}"%s"`, redacted))
+
+	for _, re := range sensitiveFieldsXMLRegexps {
+		body = re.ReplaceAllString(body, fmt.Sprintf(`${1}%s${2}`, redacted))
+	}
+
+	return body
+}
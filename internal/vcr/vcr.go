@@ -0,0 +1,199 @@
+// Package vcr records AWS API interactions to, and replays them from, cassette files.
+package vcr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	EnvVarMode     = "TF_AWS_API_RECORDER_MODE"
+	EnvVarCassette = "TF_AWS_API_RECORDER_CASSETTE"
+)
+
+// Mode is the API recorder's mode of operation.
+type Mode string
+
+const (
+	ModeRecording Mode = "RECORDING"
+	ModeReplaying Mode = "REPLAYING"
+)
+
+// Values returns all API recorder modes.
+func (Mode) Values() []Mode {
+	return []Mode{
+		ModeRecording,
+		ModeReplaying,
+	}
+}
+
+// Config configures the API recorder.
+type Config struct {
+	// Cassette is the path of the cassette file.
+	// The ".yaml" extension is optional.
+	Cassette string
+	Mode     Mode
+}
+
+// ConfigFromEnv returns the API recorder configuration from environment variables.
+// A nil Config is returned if the API recorder is not enabled.
+func ConfigFromEnv() (*Config, error) {
+	mode, cassette := os.Getenv(EnvVarMode), os.Getenv(EnvVarCassette)
+
+	if mode == "" && cassette == "" {
+		return nil, nil
+	}
+
+	if mode == "" || cassette == "" {
+		return nil, fmt.Errorf("both %s and %s must be set", EnvVarMode, EnvVarCassette)
+	}
+
+	config := &Config{
+		Cassette: cassette,
+		Mode:     Mode(mode),
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// Validate returns an error if the configuration is not valid.
+func (c *Config) Validate() error {
+	if c.Cassette == "" {
+		return errors.New("API recorder cassette must be set")
+	}
+
+	switch c.Mode {
+	case ModeRecording, ModeReplaying:
+		return nil
+	default:
+		return fmt.Errorf("unsupported API recorder mode: %s", c.Mode)
+	}
+}
+
+func (c *Config) cassetteName() string {
+	return strings.TrimSuffix(c.Cassette, ".yaml")
+}
+
+// Recorder is an http.RoundTripper that records interactions with the AWS APIs to a cassette
+// or replays previously recorded interactions.
+// In RECORDING mode the cassette is saved by SaveAll, when the provider or test shuts down.
+type Recorder struct {
+	mode     Mode
+	mutex    sync.Mutex
+	recorder *recorder.Recorder
+}
+
+// recording holds the Recorders in RECORDING mode whose cassettes are saved by SaveAll.
+var recording struct {
+	mutex     sync.Mutex
+	recorders []*Recorder
+}
+
+// NewRecorder returns a new Recorder wrapping the specified transport.
+func NewRecorder(ctx context.Context, config *Config, realTransport http.RoundTripper) (*Recorder, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	options := &recorder.Options{
+		CassetteName:  config.cassetteName(),
+		RealTransport: realTransport,
+	}
+
+	switch config.Mode {
+	case ModeRecording:
+		options.Mode = recorder.ModeRecordOnly
+	case ModeReplaying:
+		options.Mode = recorder.ModeReplayOnly
+		options.SkipRequestLatency = true
+	}
+
+	r, err := recorder.NewWithOptions(options)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating API recorder (%s): %w", config.Cassette, err)
+	}
+
+	r.AddHook(Scrub, recorder.AfterCaptureHook)
+	r.SetMatcher(Matcher(ctx))
+
+	tflog.Info(ctx, "API recorder enabled", map[string]any{
+		"tf_aws.api_recorder.cassette": config.Cassette,
+		"tf_aws.api_recorder.mode":     config.Mode,
+	})
+
+	rec := &Recorder{
+		mode:     config.Mode,
+		recorder: r,
+	}
+
+	if config.Mode == ModeRecording {
+		recording.mutex.Lock()
+		recording.recorders = append(recording.recorders, rec)
+		recording.mutex.Unlock()
+	}
+
+	return rec, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.recorder.RoundTrip(req)
+}
+
+// Save saves the interactions recorded so far to the cassette.
+// Nothing is saved in REPLAYING mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecording {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.recorder.Stop(); err != nil {
+		return fmt.Errorf("saving API recorder cassette: %w", err)
+	}
+
+	return nil
+}
+
+// SaveAll saves the cassettes of all Recorders in RECORDING mode.
+// It is called when the provider process exits and when an acceptance test finishes.
+func SaveAll() error {
+	recording.mutex.Lock()
+	recorders := recording.recorders
+	recording.mutex.Unlock()
+
+	var errs *multierror.Error
+
+	for _, r := range recorders {
+		if err := r.Save(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// IsInteractionNotFound returns whether the error is the result of
+// a request not having a recorded interaction.
+func IsInteractionNotFound(err error) bool {
+	// We have to use 'Contains' as well as 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
+	return errors.Is(err, cassette.ErrInteractionNotFound) || errs.Contains(err, cassette.ErrInteractionNotFound.Error())
+}
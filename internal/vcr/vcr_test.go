@@ -0,0 +1,237 @@
+package vcr
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestScrub(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body: "Action=AssumeRoleWithWebIdentity&RoleArn=arn&WebIdentityToken=secret",
+			Headers: http.Header{
+				"Authorization":        []string{"AWS4-HMAC-SHA256 Credential=AKIA/20230101/us-west-2/sts/aws4_request"}, //lintignore:AWSAT003
+				"Content-Type":         []string{"application/x-www-form-urlencoded"},
+				"X-Amz-Security-Token": []string{"token"},
+			},
+			URL: "https://s3.amazonaws.com/bucket/key?X-Amz-Signature=abc&versionId=1",
+		},
+		Response: cassette.Response{
+			Body: `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>{"roleCredentials":{"secretAccessKey": "secret","sessionToken":"token"}}`,
+		},
+	}
+
+	if err := Scrub(i); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []string{"Authorization", "X-Amz-Security-Token"} {
+		if _, ok := i.Request.Headers[v]; ok {
+			t.Errorf("header %s not removed", v)
+		}
+	}
+
+	if got, want := i.Request.Headers.Get("Content-Type"), "application/x-www-form-urlencoded"; got != want {
+		t.Errorf("Content-Type = %s, want %s", got, want)
+	}
+
+	if got, want := i.Request.URL, "https://s3.amazonaws.com/bucket/key?X-Amz-Signature=REDACTED&versionId=1"; got != want {
+		t.Errorf("URL = %s, want %s", got, want)
+	}
+
+	if got, want := i.Request.Body, "Action=AssumeRoleWithWebIdentity&RoleArn=arn&WebIdentityToken=REDACTED"; got != want {
+		t.Errorf("request body = %s, want %s", got, want)
+	}
+
+	for _, v := range []string{">secret<", ">token<", `"secret"`, `"token"`} {
+		if strings.Contains(i.Response.Body, v) {
+			t.Errorf("response body contains %q: %s", v, i.Response.Body)
+		}
+	}
+
+	if !strings.Contains(i.Response.Body, "<AccessKeyId>AKIA</AccessKeyId>") {
+		t.Errorf("response body unexpectedly scrubbed: %s", i.Response.Body)
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType  string
+		requestBody  string
+		cassetteBody string
+		expected     bool
+	}{
+		"identical": {
+			contentType:  "application/x-amz-json-1.1",
+			requestBody:  `{"Name":"test"}`,
+			cassetteBody: `{"Name":"test"}`,
+			expected:     true,
+		},
+		"JSON reordered": {
+			contentType:  "application/x-amz-json-1.1",
+			requestBody:  `{"Name":"test","Description":"x"}`,
+			cassetteBody: `{"Description":"x","Name":"test"}`,
+			expected:     true,
+		},
+		"JSON different": {
+			contentType:  "application/x-amz-json-1.1",
+			requestBody:  `{"Name":"test"}`,
+			cassetteBody: `{"Name":"other"}`,
+		},
+		"JSON idempotency token": {
+			contentType:  "application/x-amz-json-1.1",
+			requestBody:  `{"ClientToken":"a","Name":"test"}`,
+			cassetteBody: `{"ClientToken":"b","Name":"test"}`,
+			expected:     true,
+		},
+		"form idempotency token": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			requestBody:  "Action=RunInstances&ClientToken=a",
+			cassetteBody: "ClientToken=b&Action=RunInstances",
+			expected:     true,
+		},
+		"form scrubbed": {
+			contentType:  "application/x-www-form-urlencoded",
+			requestBody:  "Action=AssumeRoleWithWebIdentity&WebIdentityToken=secret",
+			cassetteBody: "Action=AssumeRoleWithWebIdentity&WebIdentityToken=REDACTED",
+			expected:     true,
+		},
+		"form different": {
+			contentType:  "application/x-www-form-urlencoded",
+			requestBody:  "Action=DescribeVpcs",
+			cassetteBody: "Action=DescribeSubnets",
+		},
+	}
+
+	matcher := Matcher(context.Background())
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader(testCase.requestBody))
+			r.Header.Set("Content-Type", testCase.contentType)
+
+			i := cassette.Request{
+				Body:   testCase.cassetteBody,
+				Method: http.MethodPost,
+				URL:    "https://example.com/",
+			}
+
+			if got := matcher(r, i); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, "<SessionToken>token</SessionToken>") //nolint:errcheck // Test server.
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette")
+
+	r, err := NewRecorder(ctx, &Config{Cassette: path, Mode: ModeRecording}, http.DefaultTransport)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: r}
+
+	if _, err := client.Get(server.URL); err != nil { //nolint:bodyclose,noctx // Test client.
+		t.Fatal(err)
+	}
+
+	// The cassette is only saved on request.
+	if _, err := os.Stat(path + ".yaml"); !os.IsNotExist(err) {
+		t.Fatalf("cassette saved before Save: %v", err)
+	}
+
+	if err := r.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(path + ".yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	r, err = NewRecorder(ctx, &Config{Cassette: path + ".yaml", Mode: ModeReplaying}, http.DefaultTransport)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client = &http.Client{Transport: r}
+
+	resp, err := client.Get(server.URL) //nolint:noctx // Test client.
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(body), "<SessionToken>REDACTED</SessionToken>"; got != want {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+
+	if _, err := client.Get(server.URL + "/other"); !IsInteractionNotFound(err) { //nolint:bodyclose,noctx // Test client.
+		t.Errorf("expected interaction not found error, got: %v", err)
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) { //nolint:paralleltest // Sets environment variables.
+	t.Setenv(EnvVarMode, "")
+	t.Setenv(EnvVarCassette, "")
+
+	if config, err := ConfigFromEnv(); err != nil || config != nil {
+		t.Errorf("expected no configuration, got: %v, %v", config, err)
+	}
+
+	t.Setenv(EnvVarMode, string(ModeReplaying))
+
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Error("expected error")
+	}
+
+	t.Setenv(EnvVarCassette, "test")
+
+	if config, err := ConfigFromEnv(); err != nil || config.Mode != ModeReplaying || config.Cassette != "test" {
+		t.Errorf("unexpected configuration: %v, %v", config, err)
+	}
+
+	t.Setenv(EnvVarMode, "INVALID")
+
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -3,35 +3,17 @@ package verify
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"log"
 	"reflect"
 	"regexp"
-	"strings"
 
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	tfpolicy "github.com/hashicorp/terraform-provider-aws/internal/policy"
 )
 
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
-	if strings.TrimSpace(old) == "" && strings.TrimSpace(new) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(old) == "{}" && strings.TrimSpace(new) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(old) == "" && strings.TrimSpace(new) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(old) == "{}" && strings.TrimSpace(new) == "{}" {
-		return true
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := tfpolicy.Equivalent(old, new)
 	if err != nil {
 		return false
 	}
@@ -100,89 +82,3 @@ func JSONBytesEqual(b1, b2 []byte) bool {
 
 	return reflect.DeepEqual(o1, o2)
 }
-
-func SecondJSONUnlessEquivalent(old, new string) (string, error) {
-	// valid empty JSON is "{}" not "" so handle special case to avoid
-	// Error unmarshaling policy: unexpected end of JSON input
-	if strings.TrimSpace(new) == "" {
-		return "", nil
-	}
-
-	if strings.TrimSpace(new) == "{}" {
-		return "{}", nil
-	}
-
-	if strings.TrimSpace(old) == "" || strings.TrimSpace(old) == "{}" {
-		return new, nil
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
-
-	if err != nil {
-		return "", err
-	}
-
-	if equivalent {
-		return old, nil
-	}
-
-	return new, nil
-}
-
-// PolicyToSet returns the existing policy if the new policy is equivalent.
-// Otherwise, it returns the new policy. Either policy is normalized.
-func PolicyToSet(exist, new string) (string, error) {
-	policyToSet, err := SecondJSONUnlessEquivalent(exist, new)
-	if err != nil {
-		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
-	}
-
-	policyToSet, err = structure.NormalizeJsonString(policyToSet)
-	if err != nil {
-		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
-	}
-
-	return policyToSet, nil
-}
-
-// LegacyPolicyNormalize returns a "normalized" JSON policy document except
-// the Version element is first in the JSON as required by AWS in many places.
-// Version not being first is one reason for this error:
-// MalformedPolicyDocument: The policy failed legacy parsing
-func LegacyPolicyNormalize(policy interface{}) (string, error) {
-	if policy == nil || policy.(string) == "" {
-		return "", nil
-	}
-
-	np, err := structure.NormalizeJsonString(policy)
-	if err != nil {
-		return policy.(string), fmt.Errorf("legacy policy (%s) is invalid JSON: %w", policy, err)
-	}
-
-	m := regexp.MustCompile(`(?s)^(\{\n?)(.*?)(,\s*)?(  )?("Version":\s*"2012-10-17")(,)?(\n)?(.*?)(\})`)
-
-	n := m.ReplaceAllString(np, `$1$4$5$3$2$6$7$8$9`)
-
-	_, err = structure.NormalizeJsonString(n)
-	if err != nil {
-		return policy.(string), fmt.Errorf("LegacyPolicyNormalize created a policy (%s) that is invalid JSON: %w", n, err)
-	}
-
-	return n, nil
-}
-
-// LegacyPolicyToSet returns the existing policy if the new policy is equivalent.
-// Otherwise, it returns the new policy. Either policy is legacy normalized.
-func LegacyPolicyToSet(exist, new string) (string, error) {
-	policyToSet, err := SecondJSONUnlessEquivalent(exist, new)
-	if err != nil {
-		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
-	}
-
-	policyToSet, err = LegacyPolicyNormalize(policyToSet)
-	if err != nil {
-		return "", fmt.Errorf("legacy policy (%s) is invalid JSON: %w", policyToSet, err)
-	}
-
-	return policyToSet, nil
-}
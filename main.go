@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 func main() {
@@ -35,6 +36,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Save any API recorder cassettes now that Terraform has shut the provider down.
+	if err := vcr.SaveAll(); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}